@@ -1,11 +1,10 @@
 package main
 
 import (
-	"context"
-	"os"
 	solanago "github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/polymarket/solana-program/internal/application/usecases"
+	domainservices "github.com/polymarket/solana-program/internal/domain/services"
 	"github.com/polymarket/solana-program/internal/infrastructure/repositories"
 	"github.com/polymarket/solana-program/internal/infrastructure/services"
 	"github.com/polymarket/solana-program/internal/infrastructure/solana"
@@ -25,43 +24,45 @@ func main() {
 	// Initialize program ID (in production, this would be the deployed program ID)
 	programID := solanago.MustPublicKeyFromBase58("11111111111111111111111111111111") // Placeholder
 
+	// Protocol treasury allowed to withdraw protocol fees
+	protocolTreasury := solanago.MustPublicKeyFromBase58("11111111111111111111111111111111") // Placeholder
+
+	// Deployer key allowed to initialize the program config and become its first admin
+	deployer := solanago.MustPublicKeyFromBase58("11111111111111111111111111111111") // Placeholder
+
+	// Oracle program owning the price update accounts markets may resolve from (the Pyth receiver on mainnet)
+	priceFeedProgram := solanago.MustPublicKeyFromBase58("rec5EKMGg6MxZYaMdyBfgwp4d5rB9T1VQH5pJv5LtFJ")
+
 	logger.Info("Initializing Solana program", zap.String("program_id", programID.String()))
 
 	// Initialize Solana infrastructure
-	config := solana.NewConfig(programID, "https://api.mainnet-beta.solana.com", solana.NetworkMainnet)
-	program := solana.NewProgram(config.ProgramID)
+	program := solana.NewProgram(programID)
 	accountManager := solana.NewAccountManager(program)
-	
+
 	// Initialize RPC client (optional, for on-chain operations)
 	var rpcClient *rpc.Client
-	// rpcClient = rpc.New(config.RPCEndpoint)
+	// rpcClient = rpc.New(rpc.MainNetBeta_RPC)
 
 	// Initialize serializers and validators
 	borshSerializer := solana.NewBorshSerializer()
 	accountValidator := solana.NewAccountValidator(program)
 	pdaManager := solana.NewPDAManager(program)
 	rentCalculator := solana.NewRentCalculator(rpcClient)
-	transactionHandler := solana.NewTransactionHandler(rpcClient, program, logger)
 	instructionBuilder := solana.NewInstructionBuilder(programID)
 
-	// Initialize wallet components
-	walletStorage, err := solana.NewWalletStorage("", logger)
-	if err != nil {
-		logger.Error("Failed to initialize wallet storage", zap.Error(err))
-		return
-	}
-	walletFactory := solana.NewWalletFactory(walletStorage, logger)
-	walletService := solana.NewWalletService(rpcClient, logger)
-	
-	_ = walletFactory
-	_ = walletService
-
 	// Initialize account repository
 	accountRepo := repositories.NewSolanaAccountRepository(rpcClient, accountManager, borshSerializer, accountValidator)
 
 	// Initialize repositories
 	marketRepo := repositories.NewSolanaMarketRepository(accountManager, program, borshSerializer, accountValidator, accountRepo)
 	positionRepo := repositories.NewSolanaPositionRepository(accountManager, program, borshSerializer, accountValidator, accountRepo, pdaManager)
+	liquidityRepo := repositories.NewSolanaLiquidityRepository(borshSerializer, accountValidator, accountRepo, pdaManager)
+	orderRepo := repositories.NewSolanaOrderRepository(borshSerializer, accountValidator, accountRepo, pdaManager)
+	mintRepo := repositories.NewSolanaMintRepository(accountRepo)
+	oracleRepo := repositories.NewSolanaOracleRepository(borshSerializer, accountValidator, accountRepo, priceFeedProgram)
+	committeeRepo := repositories.NewSolanaCommitteeRepository(borshSerializer, accountValidator, accountRepo)
+	voteRepo := repositories.NewSolanaVoteRepository(borshSerializer, accountValidator, accountRepo, pdaManager)
+	configRepo := repositories.NewSolanaConfigRepository(borshSerializer, accountValidator, accountRepo, pdaManager)
 	
 	// Initialize index repositories
 	marketIndexRepo := repositories.NewSolanaMarketIndexRepository(pdaManager, accountRepo)
@@ -70,42 +71,99 @@ func main() {
 	_ = marketIndexRepo
 	_ = positionIndexRepo
 	_ = rentCalculator
-	_ = transactionHandler
-	_ = instructionBuilder
 
 	// Initialize services
 	marketService := services.NewMarketServiceImpl(marketRepo)
+	constantProduct := domainservices.NewConstantProduct()
+	pricingEngine := domainservices.NewPricingRouter(domainservices.NewLMSR(), constantProduct)
+	vault := services.NewSolanaVault(accountManager, accountRepo, instructionBuilder)
+	outcomeTokens := services.NewSolanaOutcomeTokens(accountManager, instructionBuilder)
+	feeVault := services.NewSolanaFeeVault(accountManager, instructionBuilder)
+	roles := services.NewConfigRoles(configRepo)
+	pauseState := services.NewConfigPauseState(configRepo)
 
 	// Initialize use cases
-	createMarketUseCase := usecases.NewCreateMarketUseCase(marketRepo, marketService)
-	resolveMarketUseCase := usecases.NewResolveMarketUseCase(marketRepo, marketService)
-	createPositionUseCase := usecases.NewCreatePositionUseCase(positionRepo, marketRepo)
-	closeMarketUseCase := usecases.NewCloseMarketUseCase(marketRepo, marketService)
+	createMarketUseCase := usecases.NewCreateMarketUseCase(marketRepo, configRepo, marketService, vault, mintRepo, outcomeTokens)
+	resolveMarketUseCase := usecases.NewResolveMarketUseCase(marketRepo, marketService, roles)
+	createPositionUseCase := usecases.NewCreatePositionUseCase(positionRepo, marketRepo, pricingEngine, vault, feeVault, outcomeTokens, pauseState)
+	closeMarketUseCase := usecases.NewCloseMarketUseCase(marketRepo, marketService, roles)
+	sellPositionUseCase := usecases.NewSellPositionUseCase(positionRepo, marketRepo, pricingEngine, vault, feeVault, outcomeTokens, pauseState)
+	addLiquidityUseCase := usecases.NewAddLiquidityUseCase(marketRepo, liquidityRepo, constantProduct, vault, pauseState)
+	removeLiquidityUseCase := usecases.NewRemoveLiquidityUseCase(marketRepo, liquidityRepo, constantProduct, vault)
+	claimWinningsUseCase := usecases.NewClaimWinningsUseCase(positionRepo, marketRepo, vault, pauseState)
+	checkSolvencyUseCase := usecases.NewCheckSolvencyUseCase(marketRepo, vault)
+	splitUseCase := usecases.NewSplitCollateralUseCase(marketRepo, vault, outcomeTokens, pauseState)
+	mergeUseCase := usecases.NewMergeOutcomeTokensUseCase(marketRepo, vault, outcomeTokens, pauseState)
+	redeemUseCase := usecases.NewRedeemOutcomeTokensUseCase(marketRepo, vault, outcomeTokens, pauseState)
+	resolveScalarUseCase := usecases.NewResolveScalarUseCase(marketRepo, marketService, roles)
+	placeOrderUseCase := usecases.NewPlaceOrderUseCase(orderRepo, positionRepo, marketRepo, vault, feeVault, outcomeTokens, pauseState)
+	cancelOrderUseCase := usecases.NewCancelOrderUseCase(orderRepo, positionRepo, marketRepo, vault)
+	withdrawFeesUseCase := usecases.NewWithdrawFeesUseCase(marketRepo, feeVault, roles, protocolTreasury.String(), pauseState)
+	resolveFromOracleUseCase := usecases.NewResolveFromOracleUseCase(marketRepo, oracleRepo, marketService)
+	proposeResolutionUseCase := usecases.NewProposeResolutionUseCase(marketRepo, vault)
+	disputeResolutionUseCase := usecases.NewDisputeResolutionUseCase(marketRepo, vault)
+	finalizeResolutionUseCase := usecases.NewFinalizeResolutionUseCase(marketRepo, vault, pauseState)
+	arbitrateResolutionUseCase := usecases.NewArbitrateResolutionUseCase(marketRepo, vault, pauseState)
+	createCommitteeUseCase := usecases.NewCreateCommitteeUseCase(committeeRepo)
+	voteResolutionUseCase := usecases.NewVoteResolutionUseCase(marketRepo, committeeRepo, voteRepo, marketService)
+	getVoteTallyUseCase := usecases.NewGetVoteTallyUseCase(marketRepo, committeeRepo, voteRepo)
+	initializeConfigUseCase := usecases.NewInitializeConfigUseCase(configRepo, deployer.String())
+	grantRoleUseCase := usecases.NewGrantRoleUseCase(configRepo)
+	revokeRoleUseCase := usecases.NewRevokeRoleUseCase(configRepo)
+	transferAdminUseCase := usecases.NewTransferAdminUseCase(configRepo)
+	acceptAdminUseCase := usecases.NewAcceptAdminUseCase(configRepo)
+	setPausedUseCase := usecases.NewSetPausedUseCase(configRepo)
+	setMarketFrozenUseCase := usecases.NewSetMarketFrozenUseCase(marketRepo, roles)
+	setProtocolFeeUseCase := usecases.NewSetProtocolFeeUseCase(configRepo)
 
 	// Initialize instruction validator
 	instructionValidator := instructions.NewInstructionValidator(accountValidator)
 
 	// Initialize instruction handler
 	instructionHandler := instructions.NewInstructionHandler(
+		instructionValidator,
 		createMarketUseCase,
 		resolveMarketUseCase,
 		createPositionUseCase,
 		closeMarketUseCase,
+		sellPositionUseCase,
+		addLiquidityUseCase,
+		removeLiquidityUseCase,
+		claimWinningsUseCase,
+		splitUseCase,
+		mergeUseCase,
+		redeemUseCase,
+		resolveScalarUseCase,
+		placeOrderUseCase,
+		cancelOrderUseCase,
+		withdrawFeesUseCase,
+		resolveFromOracleUseCase,
+		proposeResolutionUseCase,
+		disputeResolutionUseCase,
+		finalizeResolutionUseCase,
+		arbitrateResolutionUseCase,
+		createCommitteeUseCase,
+		voteResolutionUseCase,
+		initializeConfigUseCase,
+		grantRoleUseCase,
+		revokeRoleUseCase,
+		transferAdminUseCase,
+		acceptAdminUseCase,
+		setPausedUseCase,
+		setMarketFrozenUseCase,
+		setProtocolFeeUseCase,
 	)
-	
-	_ = instructionValidator
 
-	// This is where the Solana program entry point would be
-	// In a real Solana program, this would be called by the Solana runtime
-	ctx := context.Background()
+	_ = checkSolvencyUseCase
+	_ = getVoteTallyUseCase
 
-	// Example: Process an instruction
-	// In production, this would receive instruction data from Solana runtime
+	// This is where the Solana program entry point would be
+	// In a real Solana program, the Solana runtime would pass instruction data
+	// to instructionHandler.ProcessInstruction
 	_ = instructionHandler
 
 	logger.Info("Solana program initialized",
 		zap.String("program_id", programID.String()),
-		zap.String("network", string(config.Network)),
 	)
 
 	// Keep the program running (in production, Solana runtime handles this)
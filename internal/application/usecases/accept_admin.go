@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+// AcceptAdminUseCase handles completing an admin transfer
+type AcceptAdminUseCase struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewAcceptAdminUseCase creates a new AcceptAdminUseCase
+func NewAcceptAdminUseCase(configRepo repositories.ConfigRepository) *AcceptAdminUseCase {
+	return &AcceptAdminUseCase{
+		configRepo: configRepo,
+	}
+}
+
+// AcceptAdminInput represents the input for accepting the admin role
+type AcceptAdminInput struct {
+	Caller string
+}
+
+// Execute makes the caller the admin if they are the pending admin nominated by TransferAdmin
+func (uc *AcceptAdminUseCase) Execute(ctx context.Context, input AcceptAdminInput) (*entities.ProgramConfig, error) {
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.PendingAdmin == "" || config.PendingAdmin != input.Caller {
+		return nil, entities.ErrNoPendingAdmin
+	}
+
+	config.Admin = config.PendingAdmin
+	config.PendingAdmin = ""
+	if err := uc.configRepo.Update(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+	"github.com/polymarket/solana-program/pkg/utils"
+)
+
+// AddLiquidityUseCase handles deposits into a constant-product pool
+type AddLiquidityUseCase struct {
+	marketRepo    repositories.MarketRepository
+	liquidityRepo repositories.LiquidityRepository
+	pool          services.LiquidityPool
+	vault         services.Vault
+	pause         services.PauseState
+}
+
+// NewAddLiquidityUseCase creates a new AddLiquidityUseCase
+func NewAddLiquidityUseCase(
+	marketRepo repositories.MarketRepository,
+	liquidityRepo repositories.LiquidityRepository,
+	pool services.LiquidityPool,
+	vault services.Vault,
+	pause services.PauseState,
+) *AddLiquidityUseCase {
+	return &AddLiquidityUseCase{
+		marketRepo:    marketRepo,
+		liquidityRepo: liquidityRepo,
+		pool:          pool,
+		vault:         vault,
+		pause:         pause,
+	}
+}
+
+// AddLiquidityInput represents the input for adding liquidity
+type AddLiquidityInput struct {
+	MarketID string
+	Provider string
+	Amount   uint64 // Collateral base units to deposit
+}
+
+// Execute deposits collateral into the market's pool and credits LP shares to the provider
+func (uc *AddLiquidityUseCase) Execute(ctx context.Context, input AddLiquidityInput) (*entities.LiquidityPosition, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return nil, err
+	}
+
+	if market.PricingModel != entities.PricingConstantProduct {
+		return nil, services.ErrUnsupportedPricing
+	}
+	if err := services.CheckTradingOpen(market, time.Now()); err != nil {
+		return nil, err
+	}
+
+	liquidity, isNew, err := uc.getOrNew(ctx, input.MarketID, input.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle fees earned on the current shares before the share count changes
+	if err := services.SettleFees(&market.Pool, liquidity); err != nil {
+		return nil, err
+	}
+
+	change, err := uc.pool.AddLiquidity(market, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.LockCollateral(market, change.Collateral); err != nil {
+		return nil, err
+	}
+	if err := uc.vault.Deposit(ctx, market, input.Provider, change.Collateral); err != nil {
+		return nil, err
+	}
+
+	liquidity.LPShares += change.LPShares
+	liquidity.YesShares += change.YesShares
+	liquidity.NoShares += change.NoShares
+	if err := services.ResetFeeDebt(&market.Pool, liquidity); err != nil {
+		return nil, err
+	}
+
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if isNew {
+		err = uc.liquidityRepo.Create(ctx, liquidity)
+	} else {
+		err = uc.liquidityRepo.Update(ctx, liquidity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return liquidity, nil
+}
+
+func (uc *AddLiquidityUseCase) getOrNew(ctx context.Context, marketID, provider string) (*entities.LiquidityPosition, bool, error) {
+	liquidity, err := uc.liquidityRepo.GetByMarketAndProvider(ctx, marketID, provider)
+	if err == nil {
+		return liquidity, false, nil
+	}
+	if !errors.Is(err, repositories.ErrLiquidityNotFound) {
+		return nil, false, err
+	}
+
+	id, err := DeriveLiquidityID(marketID, provider)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &entities.LiquidityPosition{
+		ID:        id,
+		MarketID:  marketID,
+		Provider:  provider,
+		CreatedAt: time.Now(),
+	}, true, nil
+}
+
+// DeriveLiquidityID derives the liquidity position ID from the market and provider,
+// matching the one liquidity account per provider per market PDA layout
+func DeriveLiquidityID(marketID, provider string) (string, error) {
+	if err := utils.ValidateID(marketID); err != nil {
+		return "", err
+	}
+
+	providerKey, err := solanautils.PublicKeyFromString(provider)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.DeriveID([]byte("liquidity"), []byte(marketID), providerKey[:]), nil
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ArbitrateResolutionUseCase handles the arbiter's decision on disputed proposals
+type ArbitrateResolutionUseCase struct {
+	marketRepo repositories.MarketRepository
+	vault      services.Vault
+	pause      services.PauseState
+}
+
+// NewArbitrateResolutionUseCase creates a new ArbitrateResolutionUseCase
+func NewArbitrateResolutionUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	pause services.PauseState,
+) *ArbitrateResolutionUseCase {
+	return &ArbitrateResolutionUseCase{
+		marketRepo: marketRepo,
+		vault:      vault,
+		pause:      pause,
+	}
+}
+
+// ArbitrateResolutionInput represents the arbiter's decision on a disputed proposal
+type ArbitrateResolutionInput struct {
+	MarketID   string
+	Arbiter    string
+	Resolution entities.MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+}
+
+// ArbitrateResolutionOutput describes the decided dispute
+type ArbitrateResolutionOutput struct {
+	Market *entities.Market
+	Winner string // Proposer if the decision matches the proposal, disputer otherwise
+	Bonds  uint64 // Both bonds, paid to the winner
+}
+
+// Execute resolves a disputed market to the arbiter's decision. The side the
+// decision agrees with gets its bond back plus the losing side's slashed bond.
+func (uc *ArbitrateResolutionUseCase) Execute(ctx context.Context, input ArbitrateResolutionInput) (*ArbitrateResolutionOutput, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return nil, err
+	}
+
+	if !market.IsOptimistic() {
+		return nil, services.ErrNotOptimisticMarket
+	}
+	if market.Status != entities.StatusDisputed || market.Proposal == nil || !market.Proposal.IsDisputed() {
+		return nil, services.ErrInvalidMarketStatus
+	}
+	if input.Arbiter != market.Optimistic.Arbiter {
+		return nil, services.ErrUnauthorized
+	}
+
+	proposal := market.Proposal
+	winner := proposal.Disputer
+	if proposal.Matches(input.Resolution, input.Outcome, input.Value) {
+		winner = proposal.Proposer
+	}
+
+	bonds, err := services.DisputeBonds(market.Optimistic)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.ApplyResolution(market, input.Resolution, input.Outcome, input.Value); err != nil {
+		return nil, err
+	}
+
+	market.Proposal = nil
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if err := uc.vault.Withdraw(ctx, market, winner, bonds); err != nil {
+		return nil, err
+	}
+
+	return &ArbitrateResolutionOutput{
+		Market: market,
+		Winner: winner,
+		Bonds:  bonds,
+	}, nil
+}
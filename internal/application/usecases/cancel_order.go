@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// CancelOrderUseCase handles cancelling resting limit orders
+type CancelOrderUseCase struct {
+	orderRepo    repositories.OrderRepository
+	positionRepo repositories.PositionRepository
+	marketRepo   repositories.MarketRepository
+	vault        services.Vault
+}
+
+// NewCancelOrderUseCase creates a new CancelOrderUseCase
+func NewCancelOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	positionRepo repositories.PositionRepository,
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+) *CancelOrderUseCase {
+	return &CancelOrderUseCase{
+		orderRepo:    orderRepo,
+		positionRepo: positionRepo,
+		marketRepo:   marketRepo,
+		vault:        vault,
+	}
+}
+
+// CancelOrderInput represents the input for cancelling an order
+type CancelOrderInput struct {
+	OrderID string
+	UserID  string
+}
+
+// Execute cancels the unfilled remainder of an order. Buy orders get their
+// remaining escrow back and sell orders release their reserved shares.
+// Orders can be cancelled in any market status, even while the protocol is
+// paused or the market frozen, so users can always take their funds back.
+func (uc *CancelOrderUseCase) Execute(ctx context.Context, input CancelOrderInput) (*entities.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != input.UserID {
+		return nil, services.ErrUnauthorized
+	}
+	if !order.IsOpen() {
+		return nil, services.ErrOrderNotOpen
+	}
+
+	market, err := uc.marketRepo.GetByID(ctx, order.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := order.Escrow
+	if order.Side == entities.OrderSell {
+		position, err := uc.positionRepo.GetByMarketAndUser(ctx, order.MarketID, order.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if position.Locked < order.Remaining() {
+			return nil, services.ErrInvalidShares
+		}
+		position.Locked -= order.Remaining()
+		if err := uc.positionRepo.Update(ctx, position); err != nil {
+			return nil, err
+		}
+	}
+
+	order.Status = entities.OrderStatusCancelled
+	order.Escrow = 0
+	order.UpdatedAt = time.Now()
+	if err := uc.orderRepo.Update(ctx, order); err != nil {
+		return nil, err
+	}
+
+	if refund > 0 {
+		if err := services.ReleaseCollateral(market, refund); err != nil {
+			return nil, err
+		}
+		market.UpdatedAt = time.Now()
+		if err := uc.marketRepo.Update(ctx, market); err != nil {
+			return nil, err
+		}
+		if err := uc.vault.Withdraw(ctx, market, order.UserID, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
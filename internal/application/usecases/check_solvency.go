@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// CheckSolvencyUseCase compares a market's recorded collateral with its vault balance
+type CheckSolvencyUseCase struct {
+	marketRepo repositories.MarketRepository
+	vault      services.Vault
+}
+
+// NewCheckSolvencyUseCase creates a new CheckSolvencyUseCase
+func NewCheckSolvencyUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+) *CheckSolvencyUseCase {
+	return &CheckSolvencyUseCase{
+		marketRepo: marketRepo,
+		vault:      vault,
+	}
+}
+
+// Execute returns ErrVaultInsolvent if the market's vault holds less than its collateral
+func (uc *CheckSolvencyUseCase) Execute(ctx context.Context, marketID string) error {
+	market, err := uc.marketRepo.GetByID(ctx, marketID)
+	if err != nil {
+		return err
+	}
+
+	balance, err := uc.vault.Balance(ctx, market)
+	if err != nil {
+		return err
+	}
+
+	return services.CheckSolvency(market, balance)
+}
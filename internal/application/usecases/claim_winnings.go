@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ClaimWinningsUseCase handles payouts of resolved markets
+type ClaimWinningsUseCase struct {
+	positionRepo repositories.PositionRepository
+	marketRepo   repositories.MarketRepository
+	vault        services.Vault
+	pause        services.PauseState
+}
+
+// NewClaimWinningsUseCase creates a new ClaimWinningsUseCase
+func NewClaimWinningsUseCase(
+	positionRepo repositories.PositionRepository,
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	pause services.PauseState,
+) *ClaimWinningsUseCase {
+	return &ClaimWinningsUseCase{
+		positionRepo: positionRepo,
+		marketRepo:   marketRepo,
+		vault:        vault,
+		pause:        pause,
+	}
+}
+
+// ClaimWinningsInput represents the input for claiming a payout
+type ClaimWinningsInput struct {
+	MarketID string
+	UserID   string
+}
+
+// Execute pays the user's position in a resolved market from the market vault.
+// Returns the collateral base units paid out.
+func (uc *ClaimWinningsUseCase) Execute(ctx context.Context, input ClaimWinningsInput) (uint64, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return 0, err
+	}
+
+	// Outcome tokens may have changed hands, so only their holders can be paid
+	if market.OutcomeTokens {
+		return 0, services.ErrOutcomeTokenMarket
+	}
+
+	position, err := uc.positionRepo.GetByMarketAndUser(ctx, input.MarketID, input.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	payout, err := services.Payout(market, position)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := services.ReleaseCollateral(market, payout); err != nil {
+		return 0, err
+	}
+
+	// Every claim releases market collateral, so the market's version check
+	// rejects a claim racing any other one before the position is marked claimed
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return 0, err
+	}
+
+	position.Claimed = true
+	if err := uc.positionRepo.Update(ctx, position); err != nil {
+		return 0, err
+	}
+
+	if err := uc.vault.Withdraw(ctx, market, input.UserID, payout); err != nil {
+		return 0, err
+	}
+
+	return payout, nil
+}
@@ -0,0 +1,226 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func TestClaimWinningsSolvency(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolution entities.MarketResolution
+		winners    []string
+	}{
+		{"yes", entities.ResolutionYes, []string{alice, carol}},
+		{"no", entities.ResolutionNo, []string{bob}},
+		{"cancelled", entities.ResolutionCancelled, []string{alice, bob, carol}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			market := f.createMarket(t, marketInput(1))
+			f.mustBuy(t, market.ID, alice, entities.SideYesValue, 3_000_000)
+			f.mustBuy(t, market.ID, bob, entities.SideNoValue, 2_000_000)
+			f.mustBuy(t, market.ID, carol, entities.SideYesValue, 1_000_000)
+			f.checkSolvency(t, market.ID)
+			f.resolve(t, market.ID, tt.resolution)
+
+			claim := NewClaimWinningsUseCase(f.positions, f.markets, f.vault, f.pause)
+			for _, user := range tt.winners {
+				position, err := f.positions.GetByMarketAndUser(ctx, market.ID, user)
+				if err != nil {
+					t.Fatalf("get position: %v", err)
+				}
+
+				paid, err := claim.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: user})
+				if err != nil {
+					t.Fatalf("claim: %v", err)
+				}
+				want := position.Shares
+				if tt.resolution == entities.ResolutionCancelled {
+					// Refunds return the escrowed cost basis, never the fees paid on top,
+					// capped at what the market holds for each share
+					want = min(position.CostBasis, position.Shares/entities.BinaryOutcomes)
+					if want >= position.Amount {
+						t.Fatalf("refund %d is not below the %d paid with fees", want, position.Amount)
+					}
+				}
+				if paid != want || f.vault.paid[user] != want {
+					t.Fatalf("claim paid %d (vault paid %d), want %d", paid, f.vault.paid[user], want)
+				}
+				f.checkSolvency(t, market.ID)
+
+				if _, err := claim.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: user}); !errors.Is(err, services.ErrAlreadyClaimed) {
+					t.Fatalf("second claim error = %v, want %v", err, services.ErrAlreadyClaimed)
+				}
+			}
+		})
+	}
+}
+
+// TestClaimWinningsCancelledRefunds checks that every holder of a cancelled
+// market can be refunded, in any order, after other traders sold out at a loss
+func TestClaimWinningsCancelledRefunds(t *testing.T) {
+	dave := testKey(7)
+	type trade struct {
+		user    string
+		outcome uint8
+		shares  uint64
+		sell    bool
+	}
+	trades := []trade{
+		{carol, entities.SideYesValue, 10_000_000, false},
+		{carol, entities.SideYesValue, 10_000_000, true},
+		{alice, entities.SideYesValue, 5_000_000, false},
+		{dave, entities.SideNoValue, 20_000_000, false},
+		{dave, entities.SideNoValue, 20_000_000, true},
+		{bob, entities.SideNoValue, 5_000_000, false},
+	}
+
+	for _, claimants := range [][]string{{alice, bob}, {bob, alice}} {
+		t.Run(strings.Join([]string{claimants[0][:4], claimants[1][:4]}, "-"), func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			if _, err := NewSetProtocolFeeUseCase(f.config).Execute(ctx, SetProtocolFeeInput{Caller: admin, ProtocolFeeBps: 0}); err != nil {
+				t.Fatalf("set protocol fee: %v", err)
+			}
+			input := marketInput(1)
+			input.CreatorFeeBps = 0
+			market := f.createMarket(t, input)
+
+			for _, trade := range trades {
+				if trade.sell {
+					f.mustSell(t, market.ID, trade.user, trade.shares)
+				} else {
+					f.mustBuy(t, market.ID, trade.user, trade.outcome, trade.shares)
+				}
+				f.checkSolvency(t, market.ID)
+			}
+			f.resolve(t, market.ID, entities.ResolutionCancelled)
+
+			claim := NewClaimWinningsUseCase(f.positions, f.markets, f.vault, f.pause)
+			for _, user := range claimants {
+				position, err := f.positions.GetByMarketAndUser(ctx, market.ID, user)
+				if err != nil {
+					t.Fatalf("get position: %v", err)
+				}
+				paid, err := claim.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: user})
+				if err != nil {
+					t.Fatalf("claim: %v", err)
+				}
+				if paid > position.CostBasis || paid > position.Shares/entities.BinaryOutcomes {
+					t.Fatalf("refunded %d for %d shares with a %d cost basis", paid, position.Shares, position.CostBasis)
+				}
+				f.checkSolvency(t, market.ID)
+			}
+		})
+	}
+}
+
+// racingMarketRepository runs race once, right after the first market lookup
+type racingMarketRepository struct {
+	repositories.MarketRepository
+	race func()
+}
+
+func (r *racingMarketRepository) GetByID(ctx context.Context, id string) (*entities.Market, error) {
+	market, err := r.MarketRepository.GetByID(ctx, id)
+	if race := r.race; race != nil {
+		r.race = nil
+		race()
+	}
+	return market, err
+}
+
+// TestClaimWinningsVersionConflict checks that a claim losing a race with
+// another claim leaves the position unclaimed, so it can be retried
+func TestClaimWinningsVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	market := f.createMarket(t, marketInput(1))
+	f.mustBuy(t, market.ID, alice, entities.SideYesValue, 1_000_000)
+	f.mustBuy(t, market.ID, carol, entities.SideYesValue, 2_000_000)
+	f.resolve(t, market.ID, entities.ResolutionYes)
+
+	claim := NewClaimWinningsUseCase(f.positions, f.markets, f.vault, f.pause)
+	repo := &racingMarketRepository{MarketRepository: f.markets, race: func() {
+		if _, err := claim.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: carol}); err != nil {
+			t.Fatalf("carol's claim: %v", err)
+		}
+	}}
+	racing := NewClaimWinningsUseCase(f.positions, repo, f.vault, f.pause)
+	if _, err := racing.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: alice}); !errors.Is(err, repositories.ErrVersionConflict) {
+		t.Fatalf("racing claim error = %v, want %v", err, repositories.ErrVersionConflict)
+	}
+	position, err := f.positions.GetByMarketAndUser(ctx, market.ID, alice)
+	if err != nil {
+		t.Fatalf("get position: %v", err)
+	}
+	if position.Claimed || f.vault.paid[alice] != 0 {
+		t.Fatalf("lost race claimed the position (claimed %v, paid %d)", position.Claimed, f.vault.paid[alice])
+	}
+	f.checkSolvency(t, market.ID)
+
+	if paid, err := claim.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: alice}); err != nil || paid != position.Shares {
+		t.Fatalf("retried claim paid %d, error %v, want %d", paid, err, position.Shares)
+	}
+	f.checkSolvency(t, market.ID)
+}
+
+func TestClaimWinningsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, f *fixture, marketID string)
+		user    string
+		wantErr error
+	}{
+		{
+			name:    "not resolved",
+			setup:   func(t *testing.T, f *fixture, marketID string) {},
+			user:    alice,
+			wantErr: services.ErrMarketNotResolved,
+		},
+		{
+			name: "losing position",
+			setup: func(t *testing.T, f *fixture, marketID string) {
+				f.resolve(t, marketID, entities.ResolutionNo)
+			},
+			user:    alice,
+			wantErr: services.ErrNothingToClaim,
+		},
+		{
+			name: "paused",
+			setup: func(t *testing.T, f *fixture, marketID string) {
+				f.resolve(t, marketID, entities.ResolutionYes)
+				if _, err := NewSetPausedUseCase(f.config).Execute(context.Background(), SetPausedInput{Caller: pauser, Paused: true}); err != nil {
+					t.Fatalf("pause: %v", err)
+				}
+			},
+			user:    alice,
+			wantErr: services.ErrProtocolPaused,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			market := f.createMarket(t, marketInput(1))
+			f.mustBuy(t, market.ID, alice, entities.SideYesValue, 1_000_000)
+			tt.setup(t, f, market.ID)
+
+			claim := NewClaimWinningsUseCase(f.positions, f.markets, f.vault, f.pause)
+			if _, err := claim.Execute(context.Background(), ClaimWinningsInput{MarketID: market.ID, UserID: tt.user}); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("claim error = %v, want %v", err, tt.wantErr)
+			}
+			if f.vault.paid[tt.user] != 0 {
+				t.Fatalf("failed claim paid %d", f.vault.paid[tt.user])
+			}
+		})
+	}
+}
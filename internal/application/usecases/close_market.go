@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/domain/repositories"
 	"github.com/polymarket/solana-program/internal/domain/services"
 )
@@ -10,16 +11,19 @@ import (
 type CloseMarketUseCase struct {
 	marketRepo   repositories.MarketRepository
 	marketService services.MarketService
+	roles         services.Roles
 }
 
 // NewCloseMarketUseCase creates a new CloseMarketUseCase
 func NewCloseMarketUseCase(
 	marketRepo repositories.MarketRepository,
 	marketService services.MarketService,
+	roles services.Roles,
 ) *CloseMarketUseCase {
 	return &CloseMarketUseCase{
 		marketRepo:   marketRepo,
 		marketService: marketService,
+		roles:         roles,
 	}
 }
 
@@ -36,9 +40,15 @@ func (uc *CloseMarketUseCase) Execute(ctx context.Context, input CloseMarketInpu
 		return err
 	}
 
-	// Check if closer is authorized
-	if market.Creator != input.Closer {
-		return services.ErrUnauthorized
+	// Check if closer is authorized: the creator or a holder of the resolver role
+	if err := services.RequireMarketRole(ctx, uc.roles, market, input.Closer, entities.RoleResolver); err != nil {
+		return err
+	}
+
+	// Only open markets can be closed; a pending proposal must be finalised or
+	// arbitrated, and a resolved market must never be reopened for resolution
+	if market.Status != entities.StatusOpen {
+		return services.ErrInvalidMarketStatus
 	}
 
 	return uc.marketService.CloseMarket(ctx, input.MarketID)
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func TestCloseMarket(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T, f *fixture, marketID string)
+		closer     string
+		wantErr    error
+		wantStatus entities.MarketStatus
+	}{
+		{"creator closes", func(*testing.T, *fixture, string) {}, creator, nil, entities.StatusClosed},
+		{"admin closes", func(*testing.T, *fixture, string) {}, admin, nil, entities.StatusClosed},
+		{"stranger", func(*testing.T, *fixture, string) {}, alice, services.ErrUnauthorized, entities.StatusOpen},
+		{
+			name: "resolved market stays resolved",
+			setup: func(t *testing.T, f *fixture, marketID string) {
+				f.resolve(t, marketID, entities.ResolutionYes)
+			},
+			closer:     creator,
+			wantErr:    services.ErrInvalidMarketStatus,
+			wantStatus: entities.StatusResolved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			market := f.createMarket(t, marketInput(1))
+			tt.setup(t, f, market.ID)
+
+			uc := NewCloseMarketUseCase(f.markets, f.marketService, f.roles)
+			err := uc.Execute(context.Background(), CloseMarketInput{MarketID: market.ID, Closer: tt.closer})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("close error = %v, want %v", err, tt.wantErr)
+			}
+			if got := f.market(t, market.ID).Status; got != tt.wantStatus {
+				t.Fatalf("status = %s, want %s", got, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+)
+
+// CreateCommitteeUseCase handles creating resolver committees
+type CreateCommitteeUseCase struct {
+	committeeRepo repositories.CommitteeRepository
+}
+
+// NewCreateCommitteeUseCase creates a new CreateCommitteeUseCase
+func NewCreateCommitteeUseCase(committeeRepo repositories.CommitteeRepository) *CreateCommitteeUseCase {
+	return &CreateCommitteeUseCase{
+		committeeRepo: committeeRepo,
+	}
+}
+
+// CreateCommitteeInput represents the input for creating a committee
+type CreateCommitteeInput struct {
+	Address   string   // Public key of the new committee account
+	Members   []string // Public keys of the members
+	Threshold uint8    // Members that must agree on a resolution
+}
+
+// Execute creates an M-of-N resolver committee. Markets resolved by the
+// committee reference its address as their oracle account.
+func (uc *CreateCommitteeUseCase) Execute(ctx context.Context, input CreateCommitteeInput) (*entities.Committee, error) {
+	committee := &entities.Committee{
+		Address:   input.Address,
+		Members:   input.Members,
+		Threshold: input.Threshold,
+	}
+
+	if err := committee.Validate(); err != nil {
+		return nil, err
+	}
+	for _, key := range append([]string{committee.Address}, committee.Members...) {
+		if _, err := solanautils.PublicKeyFromString(key); err != nil {
+			return nil, entities.ErrInvalidCommittee
+		}
+	}
+
+	// Never overwrite an existing committee account
+	_, err := uc.committeeRepo.GetByAddress(ctx, committee.Address)
+	if err == nil {
+		return nil, repositories.ErrAlreadyExists
+	}
+	if !errors.Is(err, repositories.ErrCommitteeNotFound) {
+		return nil, err
+	}
+
+	if err := uc.committeeRepo.Create(ctx, committee); err != nil {
+		return nil, err
+	}
+
+	return committee, nil
+}
@@ -2,26 +2,42 @@ package usecases
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
 	"time"
 	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/domain/repositories"
 	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+	"github.com/polymarket/solana-program/pkg/utils"
 )
 
 // CreateMarketUseCase handles market creation
 type CreateMarketUseCase struct {
 	marketRepo   repositories.MarketRepository
+	configRepo    repositories.ConfigRepository
 	marketService services.MarketService
+	vault         services.Vault
+	mintRepo      repositories.MintRepository
+	outcomeTokens services.OutcomeTokens
 }
 
 // NewCreateMarketUseCase creates a new CreateMarketUseCase
 func NewCreateMarketUseCase(
 	marketRepo repositories.MarketRepository,
+	configRepo repositories.ConfigRepository,
 	marketService services.MarketService,
+	vault services.Vault,
+	mintRepo repositories.MintRepository,
+	outcomeTokens services.OutcomeTokens,
 ) *CreateMarketUseCase {
 	return &CreateMarketUseCase{
 		marketRepo:   marketRepo,
+		configRepo:    configRepo,
 		marketService: marketService,
+		vault:         vault,
+		mintRepo:      mintRepo,
+		outcomeTokens: outcomeTokens,
 	}
 }
 
@@ -32,27 +48,112 @@ type CreateMarketInput struct {
 	Category    string
 	EndDate     time.Time
 	Creator     string
+	Nonce       uint64 // Chosen by the creator, must be unique per creator
+	Liquidity   uint64 // LMSR liquidity parameter b, in collateral base units
+	// PricingModel selects the market maker; constant-product pools start
+	// empty and are funded by liquidity providers
+	PricingModel entities.PricingModel
+	FeeBps       uint16 // Constant-product trading fee paid to liquidity providers
+	// CollateralMint is the SPL token the market settles in, e.g. USDC; empty for native SOL
+	CollateralMint string
+	// OutcomeTokens issues positions as transferable outcome SPL tokens
+	OutcomeTokens bool
+	// Outcomes names the outcomes of a categorical market; empty for YES/NO markets
+	Outcomes []string
+	// Scalar is the range of a scalar market trading LONG/SHORT; nil otherwise
+	Scalar *entities.ScalarRange
+	// CreatorFeeBps is charged on every trade along with the protocol fee set in
+	// the program config, capped together by services.MaxMarketFeeBps
+	CreatorFeeBps uint16
+	// Oracle resolves the market instead of its creator; nil for creator-resolved markets
+	Oracle *entities.Oracle
+	// Optimistic resolves the market through bonded proposals; nil otherwise
+	Optimistic *entities.OptimisticConfig
 }
 
-// Execute creates a new market
+// Execute creates a new market charging the protocol fee currently set in the program config
 func (uc *CreateMarketUseCase) Execute(ctx context.Context, input CreateMarketInput) (*entities.Market, error) {
+	marketID, err := DeriveMarketID(input.Creator, input.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	market := &entities.Market{
-		ID:          generateMarketID(),
-		Title:       input.Title,
-		Description: input.Description,
-		Category:    input.Category,
-		EndDate:     input.EndDate,
-		Resolution:  entities.ResolutionPending,
-		Status:      entities.StatusOpen,
-		Creator:     input.Creator,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:           marketID,
+		Title:        input.Title,
+		Description:  input.Description,
+		Category:     input.Category,
+		EndDate:      input.EndDate,
+		Resolution:   entities.ResolutionPending,
+		Status:       entities.StatusOpen,
+		Creator:      input.Creator,
+		Liquidity:    input.Liquidity,
+		PricingModel: input.PricingModel,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if market.PricingModel == entities.PricingConstantProduct {
+		market.Pool.FeeBps = input.FeeBps
+	}
+
+	market.CollateralMint = input.CollateralMint
+	market.OutcomeTokens = input.OutcomeTokens
+	if len(input.Outcomes) > 0 {
+		market.Outcomes = input.Outcomes
+		market.OutcomeShares = make([]uint64, len(input.Outcomes))
+	}
+	market.Scalar = input.Scalar
+	market.ProtocolFeeBps = config.ProtocolFeeBps
+	market.CreatorFeeBps = input.CreatorFeeBps
+	market.Oracle = input.Oracle
+	market.Optimistic = input.Optimistic
+	market.CollateralDecimals = entities.NativeCollateralDecimals
+	if !market.IsNativeCollateral() {
+		decimals, err := uc.mintRepo.GetDecimals(ctx, input.CollateralMint)
+		if err != nil {
+			return nil, err
+		}
+		market.CollateralDecimals = decimals
 	}
 
 	if err := uc.marketService.ValidateMarket(ctx, market); err != nil {
 		return nil, err
 	}
 
+	// Never overwrite an existing market account; any error other than not found
+	// means the account could not be checked
+	_, err = uc.marketRepo.GetByID(ctx, market.ID)
+	if err == nil {
+		return nil, repositories.ErrAlreadyExists
+	}
+	if !errors.Is(err, services.ErrMarketNotFound) {
+		return nil, err
+	}
+
+	// LMSR markets are subsidised by the creator up to the market maker's worst-case loss
+	if market.PricingModel != entities.PricingConstantProduct {
+		subsidy, err := services.NewLMSR().Subsidy(market.Liquidity, market.OutcomeCount())
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.vault.Deposit(ctx, market, input.Creator, subsidy); err != nil {
+			return nil, err
+		}
+		market.Collateral = subsidy
+	}
+
+	if market.OutcomeTokens {
+		if err := uc.outcomeTokens.CreateMints(ctx, market); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := uc.marketRepo.Create(ctx, market); err != nil {
 		return nil, err
 	}
@@ -60,9 +161,17 @@ func (uc *CreateMarketUseCase) Execute(ctx context.Context, input CreateMarketIn
 	return market, nil
 }
 
-func generateMarketID() string {
-	// In a real implementation, this would generate a unique ID
-	// For Solana, this could be a PDA (Program Derived Address)
-	return "market_" + time.Now().Format("20060102150405")
+// DeriveMarketID derives the market ID from the creator and a creator-chosen nonce,
+// so clients can compute the market PDA before sending the transaction
+func DeriveMarketID(creator string, nonce uint64) (string, error) {
+	creatorKey, err := solanautils.PublicKeyFromString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	var nonceBytes [8]byte
+	binary.LittleEndian.PutUint64(nonceBytes[:], nonce)
+
+	return utils.DeriveID([]byte("market"), creatorKey[:], nonceBytes[:]), nil
 }
 
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func TestCreateMarketDerivesID(t *testing.T) {
+	f := newFixture(t)
+	first := f.createMarket(t, marketInput(1))
+	second := f.createMarket(t, marketInput(2))
+
+	want, err := DeriveMarketID(creator, 1)
+	if err != nil {
+		t.Fatalf("DeriveMarketID() error = %v", err)
+	}
+	if first.ID != want {
+		t.Fatalf("market ID = %s, want %s", first.ID, want)
+	}
+	f.checkSolvency(t, first.ID)
+	if first.ID == second.ID {
+		t.Fatalf("nonces 1 and 2 derived the same ID %s", first.ID)
+	}
+
+	other := marketInput(1)
+	other.Creator = alice
+	if got := f.createMarket(t, other); got.ID == first.ID {
+		t.Fatalf("two creators derived the same ID %s", got.ID)
+	}
+}
+
+func TestCreateMarketCategorical(t *testing.T) {
+	f := newFixture(t)
+	input := marketInput(1)
+	input.Outcomes = []string{"Red", "Green", "Blue"}
+	market := f.createMarket(t, input)
+
+	subsidy, err := services.NewLMSR().Subsidy(market.Liquidity, market.OutcomeCount())
+	if err != nil {
+		t.Fatalf("subsidy: %v", err)
+	}
+	if market.OutcomeCount() != 3 || market.Collateral != subsidy {
+		t.Fatalf("market has %d outcomes and %d collateral, want 3 and the %d subsidy", market.OutcomeCount(), market.Collateral, subsidy)
+	}
+	f.checkSolvency(t, market.ID)
+
+	f.mustBuy(t, market.ID, alice, 2, 1_000_000)
+	f.checkSolvency(t, market.ID)
+}
+
+func TestCreateMarketNeverOverwrites(t *testing.T) {
+	f := newFixture(t)
+	first := f.createMarket(t, marketInput(7))
+
+	input := marketInput(7)
+	input.Title = "Will it snow tomorrow?"
+	uc := NewCreateMarketUseCase(f.markets, f.config, f.marketService, f.vault, nil, fakeOutcomeTokens{})
+	if _, err := uc.Execute(context.Background(), input); !errors.Is(err, repositories.ErrAlreadyExists) {
+		t.Fatalf("create error = %v, want %v", err, repositories.ErrAlreadyExists)
+	}
+	if got := f.market(t, first.ID); got.Title != first.Title {
+		t.Fatalf("existing market changed: title %q", got.Title)
+	}
+}
+
+// failingMarketRepository fails every lookup with err
+type failingMarketRepository struct {
+	repositories.MarketRepository
+	err error
+}
+
+func (r failingMarketRepository) GetByID(context.Context, string) (*entities.Market, error) {
+	return nil, r.err
+}
+
+func TestCreateMarketLookupFailure(t *testing.T) {
+	f := newFixture(t)
+	errUnavailable := errors.New("rpc unavailable")
+	repo := failingMarketRepository{MarketRepository: f.markets, err: errUnavailable}
+
+	// Only a not-found lookup means the ID is free; any other error aborts creation
+	uc := NewCreateMarketUseCase(repo, f.config, f.marketService, f.vault, nil, fakeOutcomeTokens{})
+	if _, err := uc.Execute(context.Background(), marketInput(1)); !errors.Is(err, errUnavailable) {
+		t.Fatalf("create error = %v, want %v", err, errUnavailable)
+	}
+	if len(f.vault.balances) != 0 {
+		t.Fatalf("failed creation moved collateral: %v", f.vault.balances)
+	}
+}
@@ -2,27 +2,46 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"math"
 	"time"
 
 	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/domain/repositories"
 	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+	"github.com/polymarket/solana-program/pkg/utils"
 )
 
 // CreatePositionUseCase handles position creation
 type CreatePositionUseCase struct {
-	positionRepo repositories.PositionRepository
-	marketRepo   repositories.MarketRepository
+	positionRepo  repositories.PositionRepository
+	marketRepo    repositories.MarketRepository
+	pricingEngine services.PricingEngine
+	vault         services.Vault
+	feeVault      services.FeeVault
+	outcomeTokens services.OutcomeTokens
+	pause         services.PauseState
 }
 
 // NewCreatePositionUseCase creates a new CreatePositionUseCase
 func NewCreatePositionUseCase(
 	positionRepo repositories.PositionRepository,
 	marketRepo repositories.MarketRepository,
+	pricingEngine services.PricingEngine,
+	vault services.Vault,
+	feeVault services.FeeVault,
+	outcomeTokens services.OutcomeTokens,
+	pause services.PauseState,
 ) *CreatePositionUseCase {
 	return &CreatePositionUseCase{
-		positionRepo: positionRepo,
-		marketRepo:   marketRepo,
+		positionRepo:  positionRepo,
+		marketRepo:    marketRepo,
+		pricingEngine: pricingEngine,
+		vault:         vault,
+		feeVault:      feeVault,
+		outcomeTokens: outcomeTokens,
+		pause:         pause,
 	}
 }
 
@@ -30,12 +49,15 @@ func NewCreatePositionUseCase(
 type CreatePositionInput struct {
 	MarketID string
 	UserID   string
-	Side     entities.PositionSide
-	Amount   uint64
-	Price    uint64
+	Outcome  uint8  // Outcome index; PositionSide wire value for YES/NO markets
+	Shares   uint64 // Share units to buy
+	Price    uint64 // Expected price per share in collateral base units
+	// MaxSlippageBps is how far above Price the average execution price may be
+	MaxSlippageBps uint16
 }
 
-// Execute creates a new position
+// Execute buys shares from the market maker into the user's position, creating it on
+// the first buy. See services.AddToPosition for how repeated buys are combined.
 func (uc *CreatePositionUseCase) Execute(ctx context.Context, input CreatePositionInput) (*entities.Position, error) {
 	// Validate market exists and is open
 	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
@@ -43,27 +65,141 @@ func (uc *CreatePositionUseCase) Execute(ctx context.Context, input CreatePositi
 		return nil, err
 	}
 
-	if market.Status != entities.StatusOpen {
-		return nil, services.ErrMarketClosed
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return nil, err
 	}
 
-	position := &entities.Position{
-		ID:        generatePositionID(),
-		MarketID:  input.MarketID,
-		UserID:    input.UserID,
-		Side:      input.Side,
-		Amount:    input.Amount,
-		Price:     input.Price,
-		CreatedAt: time.Now(),
+	if err := services.CheckTradingOpen(market, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := market.ValidateOutcome(input.Outcome); err != nil {
+		return nil, err
+	}
+
+	// Every user has a single position per market, matching the position PDA
+	position, created, err := uc.loadPosition(ctx, input.MarketID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Price the trade against the market maker and reject it if the price moved too far
+	quote, err := uc.pricingEngine.Buy(market, input.Outcome, input.Shares)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := uc.positionRepo.Create(ctx, position); err != nil {
+	if err := services.CheckSlippage(quote, input.Price, input.MaxSlippageBps); err != nil {
 		return nil, err
 	}
 
+	// Protocol and creator fees are paid on top of the cost and count towards the
+	// amount paid, but only the cost is escrowed and refunded if the market is cancelled
+	fees, err := services.ComputeFees(market, quote.Cost)
+	if err != nil {
+		return nil, err
+	}
+	if fees.Total() > math.MaxUint64-quote.Cost {
+		return nil, services.ErrArithmeticOverflow
+	}
+	paid := quote.Cost + fees.Total()
+
+	heldOutcome := position.Outcome
+	netted, err := services.AddToPosition(market, position, input.Outcome, quote.Shares, paid, quote.Cost)
+	if err != nil {
+		return nil, err
+	}
+
+	// Escrow the cost in the market vault and move the fees on to the fee vaults
+	if err := services.LockCollateral(market, quote.Cost); err != nil {
+		return nil, err
+	}
+	if err := services.AccrueFees(market, fees); err != nil {
+		return nil, err
+	}
+	if err := uc.vault.Deposit(ctx, market, input.UserID, paid); err != nil {
+		return nil, err
+	}
+	if err := services.CollectFees(ctx, uc.feeVault, market, fees); err != nil {
+		return nil, err
+	}
+
+	// Complete sets formed by buying the opposite outcome are paid out right away
+	if err := services.RedeemCompleteSets(market, netted); err != nil {
+		return nil, err
+	}
+
+	if market.OutcomeTokens {
+		if netted > 0 {
+			if err := uc.outcomeTokens.Burn(ctx, market, heldOutcome, input.UserID, netted); err != nil {
+				return nil, err
+			}
+		}
+		if minted := quote.Shares - netted; minted > 0 {
+			if err := uc.outcomeTokens.Mint(ctx, market, input.Outcome, input.UserID, minted); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if created {
+		err = uc.positionRepo.Create(ctx, position)
+	} else {
+		err = uc.positionRepo.Update(ctx, position)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if netted > 0 {
+		if err := uc.vault.Withdraw(ctx, market, input.UserID, netted); err != nil {
+			return nil, err
+		}
+	}
+
 	return position, nil
 }
 
-func generatePositionID() string {
-	return "position_" + time.Now().Format("20060102150405")
+// loadPosition returns the user's position in the market, or a new empty one
+// that still has to be created
+func (uc *CreatePositionUseCase) loadPosition(ctx context.Context, marketID, userID string) (*entities.Position, bool, error) {
+	position, err := uc.positionRepo.GetByMarketAndUser(ctx, marketID, userID)
+	if err == nil {
+		return position, false, nil
+	}
+	if !errors.Is(err, repositories.ErrPositionNotFound) {
+		return nil, false, err
+	}
+
+	positionID, err := DerivePositionID(marketID, userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &entities.Position{
+		ID:        positionID,
+		MarketID:  marketID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	}, true, nil
+}
+
+// DerivePositionID derives the position ID from the market and user,
+// matching the one position account per user per market PDA layout
+func DerivePositionID(marketID, userID string) (string, error) {
+	if err := utils.ValidateID(marketID); err != nil {
+		return "", err
+	}
+
+	userKey, err := solanautils.PublicKeyFromString(userID)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.DeriveID([]byte("position"), []byte(marketID), userKey[:]), nil
 }
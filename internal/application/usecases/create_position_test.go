@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// endMarket moves the market's end date into the past
+func (f *fixture) endMarket(t *testing.T, marketID string) {
+	t.Helper()
+
+	market := f.market(t, marketID)
+	market.EndDate = time.Now().Add(-time.Second)
+	if err := f.markets.Update(context.Background(), market); err != nil {
+		t.Fatalf("update market: %v", err)
+	}
+}
+
+// TestTradingEndsAtEndDate checks that nothing trades against a market once its
+// end date is reached, while liquidity can still be withdrawn after resolution
+func TestTradingEndsAtEndDate(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	pricing := services.NewPricingRouter(services.NewLMSR(), services.NewConstantProduct())
+
+	lmsr := f.createMarket(t, marketInput(1))
+	f.mustBuy(t, lmsr.ID, alice, entities.SideYesValue, 1_000_000)
+
+	input := marketInput(2)
+	input.PricingModel = entities.PricingConstantProduct
+	input.Liquidity = 0
+	pool := f.createMarket(t, input)
+	liquidity := f.addLiquidity(t, pool.ID, bob, 1_000_000)
+
+	f.endMarket(t, lmsr.ID)
+	f.endMarket(t, pool.ID)
+
+	sell := NewSellPositionUseCase(f.positions, f.markets, pricing, f.vault, f.feeVault, fakeOutcomeTokens{}, f.pause)
+	order := NewPlaceOrderUseCase(f.orders, f.positions, f.markets, f.vault, f.feeVault, fakeOutcomeTokens{}, f.pause)
+	add := NewAddLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault, f.pause)
+	remove := NewRemoveLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault)
+
+	tests := []struct {
+		name string
+		run  func() error
+	}{
+		{"buy", func() error {
+			_, err := f.buy(lmsr.ID, bob, entities.SideNoValue, 1_000)
+			return err
+		}},
+		{"sell", func() error {
+			_, err := sell.Execute(ctx, SellPositionInput{MarketID: lmsr.ID, UserID: alice, Shares: 1_000})
+			return err
+		}},
+		{"place order", func() error {
+			_, err := order.Execute(ctx, PlaceOrderInput{MarketID: lmsr.ID, UserID: bob, Outcome: entities.SideYesValue, Side: entities.OrderBuy, Price: 500_000_000, Size: 1_000, Nonce: 1})
+			return err
+		}},
+		{"add liquidity", func() error {
+			_, err := add.Execute(ctx, AddLiquidityInput{MarketID: pool.ID, Provider: carol, Amount: 1_000})
+			return err
+		}},
+		{"remove liquidity", func() error {
+			_, err := remove.Execute(ctx, RemoveLiquidityInput{MarketID: pool.ID, Provider: bob, LPShares: liquidity.LPShares})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, services.ErrTradingEnded) {
+				t.Fatalf("error = %v, want %v", err, services.ErrTradingEnded)
+			}
+		})
+	}
+
+	f.resolve(t, pool.ID, entities.ResolutionYes)
+	if _, err := remove.Execute(ctx, RemoveLiquidityInput{MarketID: pool.ID, Provider: bob, LPShares: liquidity.LPShares}); err != nil {
+		t.Fatalf("remove liquidity after resolution: %v", err)
+	}
+	f.checkSolvency(t, pool.ID)
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// DisputeResolutionUseCase handles disputes of proposed resolutions
+type DisputeResolutionUseCase struct {
+	marketRepo repositories.MarketRepository
+	vault      services.Vault
+}
+
+// NewDisputeResolutionUseCase creates a new DisputeResolutionUseCase
+func NewDisputeResolutionUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+) *DisputeResolutionUseCase {
+	return &DisputeResolutionUseCase{
+		marketRepo: marketRepo,
+		vault:      vault,
+	}
+}
+
+// DisputeResolutionInput represents the input for disputing a proposal
+type DisputeResolutionInput struct {
+	MarketID string
+	Disputer string
+}
+
+// Execute disputes a market's proposed resolution within its dispute window.
+// The disputer matches the proposer's bond and the proposal escalates to the
+// market's arbiter.
+func (uc *DisputeResolutionUseCase) Execute(ctx context.Context, input DisputeResolutionInput) (*entities.Market, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !market.IsOptimistic() {
+		return nil, services.ErrNotOptimisticMarket
+	}
+	if market.Status != entities.StatusProposed || market.Proposal == nil {
+		return nil, services.ErrInvalidMarketStatus
+	}
+
+	now := time.Now()
+	if !now.Before(market.DisputeDeadline()) {
+		return nil, services.ErrDisputeWindowClosed
+	}
+	if input.Disputer == market.Proposal.Proposer {
+		return nil, services.ErrSelfDispute
+	}
+
+	if err := uc.vault.Deposit(ctx, market, input.Disputer, market.Optimistic.Bond); err != nil {
+		return nil, err
+	}
+
+	market.Proposal.Disputer = input.Disputer
+	market.Proposal.DisputedAt = now
+	market.Status = entities.StatusDisputed
+	market.UpdatedAt = now
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	return market, nil
+}
@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// FinalizeResolutionUseCase handles finalising undisputed proposals
+type FinalizeResolutionUseCase struct {
+	marketRepo repositories.MarketRepository
+	vault      services.Vault
+	pause      services.PauseState
+}
+
+// NewFinalizeResolutionUseCase creates a new FinalizeResolutionUseCase
+func NewFinalizeResolutionUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	pause services.PauseState,
+) *FinalizeResolutionUseCase {
+	return &FinalizeResolutionUseCase{
+		marketRepo: marketRepo,
+		vault:      vault,
+		pause:      pause,
+	}
+}
+
+// FinalizeResolutionInput represents the input for finalising a proposal
+type FinalizeResolutionInput struct {
+	MarketID string
+}
+
+// Execute resolves a market to its proposal once the dispute window has ended
+// without a dispute, and returns the bond to the proposer. Anyone can finalise.
+func (uc *FinalizeResolutionUseCase) Execute(ctx context.Context, input FinalizeResolutionInput) (*entities.Market, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return nil, err
+	}
+
+	if !market.IsOptimistic() {
+		return nil, services.ErrNotOptimisticMarket
+	}
+	if market.Status != entities.StatusProposed || market.Proposal == nil {
+		return nil, services.ErrInvalidMarketStatus
+	}
+
+	now := time.Now()
+	if now.Before(market.DisputeDeadline()) {
+		return nil, services.ErrDisputeWindowOpen
+	}
+
+	proposal := market.Proposal
+	if err := services.ApplyResolution(market, proposal.Resolution, proposal.Outcome, proposal.Value); err != nil {
+		return nil, err
+	}
+
+	market.Proposal = nil
+	market.UpdatedAt = now
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if err := uc.vault.Withdraw(ctx, market, proposal.Proposer, market.Optimistic.Bond); err != nil {
+		return nil, err
+	}
+
+	return market, nil
+}
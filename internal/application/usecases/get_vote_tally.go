@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// GetVoteTallyUseCase reports the committee votes cast on a market
+type GetVoteTallyUseCase struct {
+	marketRepo    repositories.MarketRepository
+	committeeRepo repositories.CommitteeRepository
+	voteRepo      repositories.VoteRepository
+}
+
+// NewGetVoteTallyUseCase creates a new GetVoteTallyUseCase
+func NewGetVoteTallyUseCase(
+	marketRepo repositories.MarketRepository,
+	committeeRepo repositories.CommitteeRepository,
+	voteRepo repositories.VoteRepository,
+) *GetVoteTallyUseCase {
+	return &GetVoteTallyUseCase{
+		marketRepo:    marketRepo,
+		committeeRepo: committeeRepo,
+		voteRepo:      voteRepo,
+	}
+}
+
+// VoteTallyOutput describes the votes cast on a committee-resolved market
+type VoteTallyOutput struct {
+	Votes     []*entities.Vote
+	Tallies   []entities.VoteTally // Votes of current members per resolution, most voted first
+	Threshold uint8                // Votes a resolution needs to resolve the market
+}
+
+// Execute returns the votes and tallies of a committee-resolved market
+func (uc *GetVoteTallyUseCase) Execute(ctx context.Context, marketID string) (*VoteTallyOutput, error) {
+	market, err := uc.marketRepo.GetByID(ctx, marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !market.IsOracleBacked() || market.Oracle.Kind != entities.OracleCommittee {
+		return nil, services.ErrNotCommitteeMarket
+	}
+
+	committee, err := uc.committeeRepo.GetByAddress(ctx, market.Oracle.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := uc.voteRepo.GetByMarketID(ctx, market.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VoteTallyOutput{
+		Votes:     votes,
+		Tallies:   services.TallyVotes(committee, votes),
+		Threshold: committee.Threshold,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+)
+
+// GrantRoleUseCase handles granting a role in the program config
+type GrantRoleUseCase struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewGrantRoleUseCase creates a new GrantRoleUseCase
+func NewGrantRoleUseCase(configRepo repositories.ConfigRepository) *GrantRoleUseCase {
+	return &GrantRoleUseCase{
+		configRepo: configRepo,
+	}
+}
+
+// GrantRoleInput represents the input for granting a role
+type GrantRoleInput struct {
+	Caller string
+	Role   entities.Role
+	Key    string
+}
+
+// Execute grants Role to Key. Granting a role the key already holds is a no-op.
+// Only the admin can change roles, and the admin role itself only changes
+// through TransferAdmin.
+func (uc *GrantRoleUseCase) Execute(ctx context.Context, input GrantRoleInput) (*entities.ProgramConfig, error) {
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckRole(config, input.Caller, entities.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := solanautils.PublicKeyFromString(input.Key); err != nil {
+		return nil, err
+	}
+
+	if err := config.Grant(input.Role, input.Key); err != nil {
+		return nil, err
+	}
+
+	if err := uc.configRepo.Update(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
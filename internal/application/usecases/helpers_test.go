@@ -0,0 +1,232 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	memory "github.com/polymarket/solana-program/internal/infrastructure/repositories"
+	infraservices "github.com/polymarket/solana-program/internal/infrastructure/services"
+)
+
+// testKey returns a distinct public key for each seed
+func testKey(seed byte) string {
+	var key [32]byte
+	for i := range key {
+		key[i] = seed + byte(i)
+	}
+	return solanago.PublicKeyFromBytes(key[:]).String()
+}
+
+var (
+	admin   = testKey(1)
+	creator = testKey(2)
+	alice   = testKey(3)
+	bob     = testKey(4)
+	carol   = testKey(5)
+	pauser  = testKey(6)
+)
+
+// fakeVault tracks the collateral held for each market and paid to each user
+type fakeVault struct {
+	balances map[string]uint64 // Market ID to collateral held
+	paid     map[string]uint64 // User to collateral withdrawn
+}
+
+func newFakeVault() *fakeVault {
+	return &fakeVault{balances: make(map[string]uint64), paid: make(map[string]uint64)}
+}
+
+func (v *fakeVault) Deposit(_ context.Context, market *entities.Market, _ string, amount uint64) error {
+	v.balances[market.ID] += amount
+	return nil
+}
+
+func (v *fakeVault) Withdraw(_ context.Context, market *entities.Market, recipient string, amount uint64) error {
+	if amount > v.balances[market.ID] {
+		return services.ErrInsufficientVaultBalance
+	}
+	v.balances[market.ID] -= amount
+	v.paid[recipient] += amount
+	return nil
+}
+
+func (v *fakeVault) Balance(_ context.Context, market *entities.Market) (uint64, error) {
+	return v.balances[market.ID], nil
+}
+
+// fakeOutcomeTokens accepts every mint and burn
+type fakeOutcomeTokens struct{}
+
+func (fakeOutcomeTokens) CreateMints(context.Context, *entities.Market) error { return nil }
+
+func (fakeOutcomeTokens) Mint(context.Context, *entities.Market, uint8, string, uint64) error {
+	return nil
+}
+
+func (fakeOutcomeTokens) Burn(context.Context, *entities.Market, uint8, string, uint64) error {
+	return nil
+}
+
+// fakeFeeVault moves fees out of a fakeVault and records their withdrawals
+type fakeFeeVault struct {
+	vault      *fakeVault
+	collected  map[entities.FeeRecipient]uint64
+	withdrawn  map[string]uint64 // Destination to fees paid
+	onWithdraw func(market *entities.Market)
+}
+
+func newFakeFeeVault(vault *fakeVault) *fakeFeeVault {
+	return &fakeFeeVault{vault: vault, collected: make(map[entities.FeeRecipient]uint64), withdrawn: make(map[string]uint64)}
+}
+
+func (v *fakeFeeVault) Collect(_ context.Context, market *entities.Market, recipient entities.FeeRecipient, amount uint64) error {
+	if amount > v.vault.balances[market.ID] {
+		return services.ErrInsufficientVaultBalance
+	}
+	v.vault.balances[market.ID] -= amount
+	v.collected[recipient] += amount
+	return nil
+}
+
+func (v *fakeFeeVault) Withdraw(_ context.Context, market *entities.Market, recipient entities.FeeRecipient, destination string, amount uint64) error {
+	if v.onWithdraw != nil {
+		v.onWithdraw(market)
+	}
+	if amount > v.collected[recipient] {
+		return services.ErrInsufficientVaultBalance
+	}
+	v.collected[recipient] -= amount
+	v.withdrawn[destination] += amount
+	return nil
+}
+
+// fixture wires the use cases to in-memory repositories and fake vaults
+type fixture struct {
+	markets       *memory.MemoryMarketRepository
+	positions     *memory.MemoryPositionRepository
+	orders        *memory.MemoryOrderRepository
+	liquidity     *memory.MemoryLiquidityRepository
+	config        *memory.MemoryConfigRepository
+	vault         *fakeVault
+	feeVault      *fakeFeeVault
+	pause         services.PauseState
+	roles         services.Roles
+	marketService services.MarketService
+}
+
+// newFixture creates a fixture whose config has admin as its admin, pauser as a
+// pauser and a 100 bps protocol fee
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	vault := newFakeVault()
+	f := &fixture{
+		markets:   memory.NewMemoryMarketRepository(),
+		positions: memory.NewMemoryPositionRepository(),
+		orders:    memory.NewMemoryOrderRepository(),
+		liquidity: memory.NewMemoryLiquidityRepository(),
+		config:    memory.NewMemoryConfigRepository(),
+		vault:     vault,
+		feeVault:  newFakeFeeVault(vault),
+	}
+	f.pause = infraservices.NewConfigPauseState(f.config)
+	f.roles = infraservices.NewConfigRoles(f.config)
+	f.marketService = infraservices.NewMarketServiceImpl(f.markets)
+
+	config := &entities.ProgramConfig{Admin: admin, Pausers: []string{pauser}, ProtocolFeeBps: 100}
+	if err := f.config.Create(context.Background(), config); err != nil {
+		t.Fatalf("create config: %v", err)
+	}
+	return f
+}
+
+// marketInput returns the input of a YES/NO LMSR market created by creator
+func marketInput(nonce uint64) CreateMarketInput {
+	return CreateMarketInput{
+		Title:         "Will it rain tomorrow?",
+		EndDate:       time.Now().Add(24 * time.Hour),
+		Creator:       creator,
+		Nonce:         nonce,
+		Liquidity:     1_000_000,
+		PricingModel:  entities.PricingLMSR,
+		CreatorFeeBps: 50,
+	}
+}
+
+func (f *fixture) createMarket(t *testing.T, input CreateMarketInput) *entities.Market {
+	t.Helper()
+
+	uc := NewCreateMarketUseCase(f.markets, f.config, f.marketService, f.vault, nil, fakeOutcomeTokens{})
+	market, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("create market: %v", err)
+	}
+	return market
+}
+
+func (f *fixture) buy(marketID, user string, outcome uint8, shares uint64) (*entities.Position, error) {
+	pricing := services.NewPricingRouter(services.NewLMSR(), services.NewConstantProduct())
+	uc := NewCreatePositionUseCase(f.positions, f.markets, pricing, f.vault, f.feeVault, fakeOutcomeTokens{}, f.pause)
+	return uc.Execute(context.Background(), CreatePositionInput{
+		MarketID:       marketID,
+		UserID:         user,
+		Outcome:        outcome,
+		Shares:         shares,
+		Price:          services.PriceScale,
+		MaxSlippageBps: 100,
+	})
+}
+
+func (f *fixture) mustBuy(t *testing.T, marketID, user string, outcome uint8, shares uint64) *entities.Position {
+	t.Helper()
+
+	position, err := f.buy(marketID, user, outcome, shares)
+	if err != nil {
+		t.Fatalf("buy %d shares of outcome %d: %v", shares, outcome, err)
+	}
+	return position
+}
+
+// mustSell sells shares of the user's position at any price
+func (f *fixture) mustSell(t *testing.T, marketID, user string, shares uint64) {
+	t.Helper()
+
+	pricing := services.NewPricingRouter(services.NewLMSR(), services.NewConstantProduct())
+	uc := NewSellPositionUseCase(f.positions, f.markets, pricing, f.vault, f.feeVault, fakeOutcomeTokens{}, f.pause)
+	if _, err := uc.Execute(context.Background(), SellPositionInput{MarketID: marketID, UserID: user, Shares: shares}); err != nil {
+		t.Fatalf("sell %d shares: %v", shares, err)
+	}
+}
+
+func (f *fixture) resolve(t *testing.T, marketID string, resolution entities.MarketResolution) {
+	t.Helper()
+
+	uc := NewResolveMarketUseCase(f.markets, f.marketService, f.roles)
+	if err := uc.Execute(context.Background(), ResolveMarketInput{MarketID: marketID, Resolution: resolution, Resolver: creator}); err != nil {
+		t.Fatalf("resolve market: %v", err)
+	}
+}
+
+func (f *fixture) market(t *testing.T, marketID string) *entities.Market {
+	t.Helper()
+
+	market, err := f.markets.GetByID(context.Background(), marketID)
+	if err != nil {
+		t.Fatalf("get market: %v", err)
+	}
+	return market
+}
+
+// checkSolvency fails the test unless the vault holds exactly the market's recorded collateral
+func (f *fixture) checkSolvency(t *testing.T, marketID string) {
+	t.Helper()
+
+	market := f.market(t, marketID)
+	if balance := f.vault.balances[marketID]; balance != market.Collateral {
+		t.Fatalf("vault holds %d, market records %d collateral", balance, market.Collateral)
+	}
+}
@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+)
+
+// InitializeConfigUseCase handles creating the program config
+type InitializeConfigUseCase struct {
+	configRepo repositories.ConfigRepository
+	deployer   string // Public key allowed to initialize the config
+}
+
+// NewInitializeConfigUseCase creates a new InitializeConfigUseCase
+func NewInitializeConfigUseCase(configRepo repositories.ConfigRepository, deployer string) *InitializeConfigUseCase {
+	return &InitializeConfigUseCase{
+		configRepo: configRepo,
+		deployer:   deployer,
+	}
+}
+
+// InitializeConfigInput represents the input for initializing the program config
+type InitializeConfigInput struct {
+	Admin string
+}
+
+// Execute creates the program config with Admin as its admin and no other
+// role holders. The config can only be initialized once, and only by the
+// deployer key, so nobody can front-run deployment to become admin.
+func (uc *InitializeConfigUseCase) Execute(ctx context.Context, input InitializeConfigInput) (*entities.ProgramConfig, error) {
+	if _, err := solanautils.PublicKeyFromString(input.Admin); err != nil {
+		return nil, err
+	}
+	if input.Admin != uc.deployer {
+		return nil, services.ErrUnauthorized
+	}
+
+	_, err := uc.configRepo.Get(ctx)
+	if err == nil {
+		return nil, repositories.ErrAlreadyExists
+	}
+	if !errors.Is(err, repositories.ErrConfigNotFound) {
+		return nil, err
+	}
+
+	config := &entities.ProgramConfig{
+		Admin: input.Admin,
+	}
+	if err := uc.configRepo.Create(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
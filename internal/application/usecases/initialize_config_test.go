@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	memory "github.com/polymarket/solana-program/internal/infrastructure/repositories"
+)
+
+func TestInitializeConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		initialized bool
+		admin       string
+		wantErr     error
+	}{
+		{"deployer", false, admin, nil},
+		{"front-runner", false, alice, services.ErrUnauthorized},
+		{"already initialized", true, admin, repositories.ErrAlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			configRepo := memory.NewMemoryConfigRepository()
+			uc := NewInitializeConfigUseCase(configRepo, admin)
+			if tt.initialized {
+				if _, err := uc.Execute(ctx, InitializeConfigInput{Admin: admin}); err != nil {
+					t.Fatalf("first initialize: %v", err)
+				}
+			}
+
+			config, err := uc.Execute(ctx, InitializeConfigInput{Admin: tt.admin})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("initialize error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && config.Admin != tt.admin {
+				t.Fatalf("admin = %s, want %s", config.Admin, tt.admin)
+			}
+		})
+	}
+}
+
+func TestInitializeConfigRejectsInvalidKey(t *testing.T) {
+	uc := NewInitializeConfigUseCase(memory.NewMemoryConfigRepository(), admin)
+	if _, err := uc.Execute(context.Background(), InitializeConfigInput{Admin: "not a key"}); err == nil {
+		t.Fatal("initialize with an invalid key succeeded")
+	}
+}
@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// MergeOutcomeTokensUseCase turns complete sets of outcome tokens back into collateral
+type MergeOutcomeTokensUseCase struct {
+	marketRepo    repositories.MarketRepository
+	vault         services.Vault
+	outcomeTokens services.OutcomeTokens
+	pause         services.PauseState
+}
+
+// NewMergeOutcomeTokensUseCase creates a new MergeOutcomeTokensUseCase
+func NewMergeOutcomeTokensUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	outcomeTokens services.OutcomeTokens,
+	pause services.PauseState,
+) *MergeOutcomeTokensUseCase {
+	return &MergeOutcomeTokensUseCase{
+		marketRepo:    marketRepo,
+		vault:         vault,
+		outcomeTokens: outcomeTokens,
+		pause:         pause,
+	}
+}
+
+// MergeOutcomeTokensInput represents the input for merging outcome tokens
+type MergeOutcomeTokensInput struct {
+	MarketID string
+	UserID   string
+	Amount   uint64 // Complete sets to burn, each returning 1 collateral base unit
+}
+
+// Execute burns Amount tokens of every outcome and returns Amount collateral to the user.
+// After resolution tokens are redeemed individually instead.
+func (uc *MergeOutcomeTokensUseCase) Execute(ctx context.Context, input MergeOutcomeTokensInput) error {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return err
+	}
+
+	if !market.OutcomeTokens {
+		return services.ErrOutcomeTokensDisabled
+	}
+	if market.Status == entities.StatusResolved {
+		return services.ErrInvalidMarketStatus
+	}
+	if input.Amount == 0 {
+		return services.ErrInvalidShares
+	}
+
+	if err := services.ReleaseCollateral(market, input.Amount); err != nil {
+		return err
+	}
+
+	for outcome := 0; outcome < market.OutcomeCount(); outcome++ {
+		if err := uc.outcomeTokens.Burn(ctx, market, uint8(outcome), input.UserID, input.Amount); err != nil {
+			return err
+		}
+	}
+
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return err
+	}
+
+	return uc.vault.Withdraw(ctx, market, input.UserID, input.Amount)
+}
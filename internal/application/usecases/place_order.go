@@ -0,0 +1,432 @@
+package usecases
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+	"github.com/polymarket/solana-program/pkg/utils"
+)
+
+// PlaceOrderUseCase handles placing limit orders on a market's order book
+type PlaceOrderUseCase struct {
+	orderRepo     repositories.OrderRepository
+	positionRepo  repositories.PositionRepository
+	marketRepo    repositories.MarketRepository
+	vault         services.Vault
+	feeVault      services.FeeVault
+	outcomeTokens services.OutcomeTokens
+	pause         services.PauseState
+}
+
+// NewPlaceOrderUseCase creates a new PlaceOrderUseCase
+func NewPlaceOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	positionRepo repositories.PositionRepository,
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	feeVault services.FeeVault,
+	outcomeTokens services.OutcomeTokens,
+	pause services.PauseState,
+) *PlaceOrderUseCase {
+	return &PlaceOrderUseCase{
+		orderRepo:     orderRepo,
+		positionRepo:  positionRepo,
+		marketRepo:    marketRepo,
+		vault:         vault,
+		feeVault:      feeVault,
+		outcomeTokens: outcomeTokens,
+		pause:         pause,
+	}
+}
+
+// PlaceOrderInput represents the input for placing a limit order
+type PlaceOrderInput struct {
+	MarketID string
+	UserID   string
+	Outcome  uint8
+	Side     entities.OrderSide
+	Price    uint64 // Limit price per full share in collateral base units
+	Size     uint64 // Share units to trade
+	Nonce    uint64 // Chosen by the user, must be unique per user per market
+}
+
+// PlaceOrderOutput describes the placed order and the trades it executed
+type PlaceOrderOutput struct {
+	Order *entities.Order
+	Fills []*entities.Fill
+}
+
+// Execute places a limit order and matches it against the book.
+// Buy orders escrow their full cost in the market vault and sell orders reserve
+// shares of the user's position; whatever does not fill rests on the book.
+func (uc *PlaceOrderUseCase) Execute(ctx context.Context, input PlaceOrderInput) (*PlaceOrderOutput, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckTradingOpen(market, time.Now()); err != nil {
+		return nil, err
+	}
+
+	orderID, err := DeriveOrderID(input.MarketID, input.UserID, input.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &entities.Order{
+		ID:        orderID,
+		MarketID:  input.MarketID,
+		UserID:    input.UserID,
+		Outcome:   input.Outcome,
+		Side:      input.Side,
+		Price:     input.Price,
+		Size:      input.Size,
+		Status:    entities.OrderStatusOpen,
+		Sequence:  market.OrderSequence,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := services.ValidateOrder(market, order); err != nil {
+		return nil, err
+	}
+
+	// Never overwrite an existing order account
+	_, err = uc.orderRepo.GetByID(ctx, order.ID)
+	if err == nil {
+		return nil, repositories.ErrAlreadyExists
+	}
+	if !errors.Is(err, repositories.ErrOrderNotFound) {
+		return nil, err
+	}
+
+	positions := newFillPositions(uc.positionRepo, market.ID)
+	position, err := positions.get(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if position.Shares > 0 && position.Outcome != order.Outcome {
+		return nil, services.ErrOrderOutcome
+	}
+
+	if order.Side == entities.OrderSell {
+		if order.Size > position.AvailableShares() {
+			return nil, services.ErrInvalidShares
+		}
+		position.Locked += order.Size
+	} else {
+		escrow, err := services.OrderEscrow(order)
+		if err != nil {
+			return nil, err
+		}
+		if err := services.LockCollateral(market, escrow); err != nil {
+			return nil, err
+		}
+		if err := uc.vault.Deposit(ctx, market, input.UserID, escrow); err != nil {
+			return nil, err
+		}
+		order.Escrow = escrow
+	}
+
+	resting, err := uc.orderRepo.GetOpenByMarket(ctx, market.ID, order.Outcome)
+	if err != nil {
+		return nil, err
+	}
+
+	resting, stale, err := uc.cancelStaleOrders(ctx, market, positions, order, resting)
+	if err != nil {
+		return nil, err
+	}
+
+	fills, err := services.MatchOrder(order, resting)
+	if err != nil {
+		return nil, err
+	}
+
+	makers := make(map[string]*entities.Order, len(fills))
+	for _, maker := range resting {
+		makers[maker.ID] = maker
+	}
+
+	for _, fill := range fills {
+		// The buyer's escrow pays the seller, less the protocol and creator fees
+		proceeds, fees, err := services.DeductFees(market, fill.Cost)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := positions.apply(ctx, fill, proceeds); err != nil {
+			return nil, err
+		}
+
+		if err := services.ReleaseCollateral(market, fill.Cost); err != nil {
+			return nil, err
+		}
+		if err := services.AccrueFees(market, fees); err != nil {
+			return nil, err
+		}
+		if err := services.CollectFees(ctx, uc.feeVault, market, fees); err != nil {
+			return nil, err
+		}
+		if err := uc.vault.Withdraw(ctx, market, fill.Seller, proceeds); err != nil {
+			return nil, err
+		}
+
+		if market.OutcomeTokens {
+			if err := uc.outcomeTokens.Burn(ctx, market, fill.Outcome, fill.Seller, fill.Shares); err != nil {
+				return nil, err
+			}
+			if err := uc.outcomeTokens.Mint(ctx, market, fill.Outcome, fill.Buyer, fill.Shares); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Buy orders that filled below their limit return the unused escrow
+	matched := []*entities.Order{order}
+	for _, fill := range fills {
+		matched = append(matched, makers[fill.MakerOrderID])
+	}
+	for _, o := range matched {
+		if o.Status != entities.OrderStatusFilled || o.Escrow == 0 {
+			continue
+		}
+		if err := services.ReleaseCollateral(market, o.Escrow); err != nil {
+			return nil, err
+		}
+		if err := uc.vault.Withdraw(ctx, market, o.UserID, o.Escrow); err != nil {
+			return nil, err
+		}
+		o.Escrow = 0
+	}
+
+	market.OrderSequence++
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if err := uc.orderRepo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+	for _, fill := range fills {
+		maker := makers[fill.MakerOrderID]
+		maker.UpdatedAt = time.Now()
+		if err := uc.orderRepo.Update(ctx, maker); err != nil {
+			return nil, err
+		}
+	}
+	for _, o := range stale {
+		o.UpdatedAt = time.Now()
+		if err := uc.orderRepo.Update(ctx, o); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := positions.save(ctx); err != nil {
+		return nil, err
+	}
+
+	return &PlaceOrderOutput{
+		Order: order,
+		Fills: fills,
+	}, nil
+}
+
+// cancelStaleOrders cancels the resting orders taker could trade with whose
+// owner's position no longer holds the order's outcome, e.g. a user whose buy
+// order rests while their position switched to the other outcome. Such an order
+// can never fill, and leaving it on the book would block every taker that
+// crosses it. Buy orders get their escrow back and sell orders release their
+// reserved shares. Returns the orders still resting and the ones cancelled.
+func (uc *PlaceOrderUseCase) cancelStaleOrders(
+	ctx context.Context,
+	market *entities.Market,
+	positions *fillPositions,
+	taker *entities.Order,
+	resting []*entities.Order,
+) ([]*entities.Order, []*entities.Order, error) {
+	live := make([]*entities.Order, 0, len(resting))
+	stale := make([]*entities.Order, 0)
+	for _, maker := range resting {
+		if !maker.IsOpen() || maker.Side != taker.Side.Opposite() || maker.UserID == taker.UserID {
+			live = append(live, maker)
+			continue
+		}
+
+		position, err := positions.get(ctx, maker.UserID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isStaleOrder(position, maker) {
+			live = append(live, maker)
+			continue
+		}
+
+		if maker.Side == entities.OrderSell {
+			released := maker.Remaining()
+			if released > position.Locked {
+				released = position.Locked
+			}
+			position.Locked -= released
+		} else if maker.Escrow > 0 {
+			if err := services.ReleaseCollateral(market, maker.Escrow); err != nil {
+				return nil, nil, err
+			}
+			if err := uc.vault.Withdraw(ctx, market, maker.UserID, maker.Escrow); err != nil {
+				return nil, nil, err
+			}
+		}
+		maker.Status = entities.OrderStatusCancelled
+		maker.Escrow = 0
+		stale = append(stale, maker)
+	}
+	return live, stale, nil
+}
+
+// isStaleOrder reports whether a resting order can no longer fill against the
+// owner's position: a buy order's outcome differs from the shares held, or a
+// sell order's shares are no longer reserved
+func isStaleOrder(position *entities.Position, order *entities.Order) bool {
+	if order.Side == entities.OrderBuy {
+		return position.Shares > 0 && position.Outcome != order.Outcome
+	}
+	return position.Outcome != order.Outcome || position.Locked < order.Remaining()
+}
+
+// DeriveOrderID derives the order ID from the market, the user and a user-chosen nonce,
+// so clients can compute the order PDA before sending the transaction
+func DeriveOrderID(marketID, userID string, nonce uint64) (string, error) {
+	if err := utils.ValidateID(marketID); err != nil {
+		return "", err
+	}
+
+	userKey, err := solanautils.PublicKeyFromString(userID)
+	if err != nil {
+		return "", err
+	}
+
+	var nonceBytes [8]byte
+	binary.LittleEndian.PutUint64(nonceBytes[:], nonce)
+
+	return utils.DeriveID([]byte("order"), []byte(marketID), userKey[:], nonceBytes[:]), nil
+}
+
+// fillPositions loads and updates the positions of the users trading in one
+// order placement, so a user filled several times is read and written once
+type fillPositions struct {
+	repo      repositories.PositionRepository
+	marketID  string
+	positions map[string]*entities.Position
+	created   map[string]bool
+	order     []string
+}
+
+func newFillPositions(repo repositories.PositionRepository, marketID string) *fillPositions {
+	return &fillPositions{
+		repo:      repo,
+		marketID:  marketID,
+		positions: make(map[string]*entities.Position),
+		created:   make(map[string]bool),
+	}
+}
+
+// get returns the user's position, starting an empty one if the user has none
+func (p *fillPositions) get(ctx context.Context, userID string) (*entities.Position, error) {
+	if position, ok := p.positions[userID]; ok {
+		return position, nil
+	}
+
+	position, err := p.repo.GetByMarketAndUser(ctx, p.marketID, userID)
+	if errors.Is(err, repositories.ErrPositionNotFound) {
+		positionID, err := DerivePositionID(p.marketID, userID)
+		if err != nil {
+			return nil, err
+		}
+		position = &entities.Position{
+			ID:        positionID,
+			MarketID:  p.marketID,
+			UserID:    userID,
+			CreatedAt: time.Now(),
+		}
+		p.created[userID] = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	p.positions[userID] = position
+	p.order = append(p.order, userID)
+	return position, nil
+}
+
+// apply moves the filled shares from the seller's position to the buyer's,
+// the seller receiving proceeds for them. The escrowed cost basis backing the
+// shares moves with them, since the fill leaves the market's collateral unchanged.
+func (p *fillPositions) apply(ctx context.Context, fill *entities.Fill, proceeds uint64) error {
+	seller, err := p.get(ctx, fill.Seller)
+	if err != nil {
+		return err
+	}
+	if seller.Outcome != fill.Outcome || fill.Shares > seller.Locked {
+		return services.ErrOrderOutcome
+	}
+	basis, err := services.EscrowedBasis(seller, fill.Shares)
+	if err != nil {
+		return err
+	}
+
+	// Release the cost basis of the sold shares and record the realised PnL
+	if _, err := services.ReducePosition(seller, fill.Shares, proceeds); err != nil {
+		return err
+	}
+	seller.Locked -= fill.Shares
+
+	buyer, err := p.get(ctx, fill.Buyer)
+	if err != nil {
+		return err
+	}
+	if buyer.Shares > 0 && buyer.Outcome != fill.Outcome {
+		return services.ErrOrderOutcome
+	}
+
+	buyer.Outcome = fill.Outcome
+	buyer.Amount += fill.Cost
+	buyer.CostBasis += basis
+	buyer.Shares += fill.Shares
+	if buyer.Price, err = services.AveragePrice(buyer.Amount, buyer.Shares); err != nil {
+		return err
+	}
+	return nil
+}
+
+// save creates or updates every position touched, in the order they were loaded
+func (p *fillPositions) save(ctx context.Context) error {
+	for _, userID := range p.order {
+		position := p.positions[userID]
+		if p.created[userID] {
+			// A new position is only stored if the user ended up holding shares
+			if position.Shares == 0 {
+				continue
+			}
+			if err := p.repo.Create(ctx, position); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := p.repo.Update(ctx, position); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func (f *fixture) placeOrder(t *testing.T, input PlaceOrderInput) *PlaceOrderOutput {
+	t.Helper()
+
+	uc := NewPlaceOrderUseCase(f.orders, f.positions, f.markets, f.vault, f.feeVault, fakeOutcomeTokens{}, f.pause)
+	output, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("place order: %v", err)
+	}
+	return output
+}
+
+func (f *fixture) order(t *testing.T, id string) *entities.Order {
+	t.Helper()
+
+	order, err := f.orders.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get order: %v", err)
+	}
+	return order
+}
+
+func TestPlaceOrderMatching(t *testing.T) {
+	const size = 1_000_000
+	tests := []struct {
+		name       string
+		bidPrice   uint64 // Alice's resting buy order
+		askPrice   uint64 // Bob's incoming sell order
+		wantShares uint64
+	}{
+		{"crossing fills at the maker's price", 600_000_000, 500_000_000, size},
+		{"equal prices fill", 500_000_000, 500_000_000, size},
+		{"no cross rests", 400_000_000, 500_000_000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			market := f.createMarket(t, marketInput(1))
+			bobBefore := f.mustBuy(t, market.ID, bob, entities.SideYesValue, size)
+
+			bid := f.placeOrder(t, PlaceOrderInput{MarketID: market.ID, UserID: alice, Outcome: entities.SideYesValue, Side: entities.OrderBuy, Price: tt.bidPrice, Size: size, Nonce: 1})
+			ask := f.placeOrder(t, PlaceOrderInput{MarketID: market.ID, UserID: bob, Outcome: entities.SideYesValue, Side: entities.OrderSell, Price: tt.askPrice, Size: size, Nonce: 1})
+			f.checkSolvency(t, market.ID)
+
+			var filled uint64
+			for _, fill := range ask.Fills {
+				filled += fill.Shares
+				if fill.Price != tt.bidPrice || fill.Buyer != alice || fill.Seller != bob {
+					t.Fatalf("fill = %+v, want alice buying from bob at %d", fill, tt.bidPrice)
+				}
+			}
+			if filled != tt.wantShares {
+				t.Fatalf("filled %d shares, want %d", filled, tt.wantShares)
+			}
+			if filled == 0 {
+				if f.order(t, bid.Order.ID).Status != entities.OrderStatusOpen || f.order(t, ask.Order.ID).Status != entities.OrderStatusOpen {
+					t.Fatal("orders that do not cross must both rest")
+				}
+				return
+			}
+
+			// Bob is paid the fill less fees, and his escrowed cost basis moves to alice
+			proceeds, _, err := services.DeductFees(market, ask.Fills[0].Cost)
+			if err != nil {
+				t.Fatalf("deduct fees: %v", err)
+			}
+			if f.vault.paid[bob] != proceeds {
+				t.Fatalf("bob was paid %d, want %d", f.vault.paid[bob], proceeds)
+			}
+			alicePosition, err := f.positions.GetByMarketAndUser(ctx, market.ID, alice)
+			if err != nil {
+				t.Fatalf("get alice's position: %v", err)
+			}
+			if alicePosition.Shares != size || alicePosition.CostBasis != bobBefore.CostBasis {
+				t.Fatalf("alice holds %d shares with %d basis, want %d and %d", alicePosition.Shares, alicePosition.CostBasis, size, bobBefore.CostBasis)
+			}
+			if f.order(t, bid.Order.ID).Status != entities.OrderStatusFilled {
+				t.Fatal("alice's order was not filled")
+			}
+		})
+	}
+}
+
+func TestPlaceOrderCancelsStaleMakers(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	market := f.createMarket(t, marketInput(1))
+
+	// Alice bids for YES, then switches her position to NO, so her bid can never fill
+	bid := f.placeOrder(t, PlaceOrderInput{MarketID: market.ID, UserID: alice, Outcome: entities.SideYesValue, Side: entities.OrderBuy, Price: 500_000_000, Size: 1_000, Nonce: 1})
+	f.mustBuy(t, market.ID, alice, entities.SideNoValue, 2_000)
+	f.mustBuy(t, market.ID, bob, entities.SideYesValue, 1_000)
+
+	ask := f.placeOrder(t, PlaceOrderInput{MarketID: market.ID, UserID: bob, Outcome: entities.SideYesValue, Side: entities.OrderSell, Price: 400_000_000, Size: 1_000, Nonce: 1})
+	if len(ask.Fills) != 0 {
+		t.Fatalf("stale bid was filled: %+v", ask.Fills)
+	}
+
+	stale := f.order(t, bid.Order.ID)
+	if stale.Status != entities.OrderStatusCancelled || stale.Escrow != 0 {
+		t.Fatalf("stale bid = %s with escrow %d, want cancelled with none", stale.Status, stale.Escrow)
+	}
+	if f.vault.paid[alice] != bid.Order.Escrow {
+		t.Fatalf("alice got back %d, want her %d escrow", f.vault.paid[alice], bid.Order.Escrow)
+	}
+	if f.order(t, ask.Order.ID).Status != entities.OrderStatusOpen {
+		t.Fatal("bob's ask does not rest on the book")
+	}
+	f.checkSolvency(t, market.ID)
+
+	// The book is usable again: a fresh bid fills against bob's ask
+	carolBid := f.placeOrder(t, PlaceOrderInput{MarketID: market.ID, UserID: carol, Outcome: entities.SideYesValue, Side: entities.OrderBuy, Price: 400_000_000, Size: 1_000, Nonce: 1})
+	if len(carolBid.Fills) != 1 {
+		t.Fatalf("carol's bid made %d fills, want 1", len(carolBid.Fills))
+	}
+	if _, err := f.positions.GetByMarketAndUser(ctx, market.ID, carol); err != nil {
+		t.Fatalf("carol has no position after the fill: %v", err)
+	}
+	f.checkSolvency(t, market.ID)
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ProposeResolutionUseCase handles bonded resolution proposals for optimistic markets
+type ProposeResolutionUseCase struct {
+	marketRepo repositories.MarketRepository
+	vault      services.Vault
+}
+
+// NewProposeResolutionUseCase creates a new ProposeResolutionUseCase
+func NewProposeResolutionUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+) *ProposeResolutionUseCase {
+	return &ProposeResolutionUseCase{
+		marketRepo: marketRepo,
+		vault:      vault,
+	}
+}
+
+// ProposeResolutionInput represents the input for proposing a resolution
+type ProposeResolutionInput struct {
+	MarketID   string
+	Proposer   string
+	Resolution entities.MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+}
+
+// Execute proposes a resolution for an optimistic market once it has ended.
+// Anyone can propose; the proposer's bond is escrowed in the market vault,
+// outside the market's collateral, and the market enters StatusProposed
+// until the dispute window ends or the proposal is disputed.
+func (uc *ProposeResolutionUseCase) Execute(ctx context.Context, input ProposeResolutionInput) (*entities.Market, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !market.IsOptimistic() {
+		return nil, services.ErrNotOptimisticMarket
+	}
+	if market.Status != entities.StatusOpen && market.Status != entities.StatusClosed {
+		return nil, services.ErrInvalidMarketStatus
+	}
+
+	now := time.Now()
+	if now.Before(market.EndDate) {
+		return nil, services.ErrMarketNotEnded
+	}
+
+	if err := services.ValidateReportedResolution(market, input.Resolution, input.Outcome); err != nil {
+		return nil, err
+	}
+
+	if err := uc.vault.Deposit(ctx, market, input.Proposer, market.Optimistic.Bond); err != nil {
+		return nil, err
+	}
+
+	market.Proposal = &entities.Proposal{
+		Proposer:   input.Proposer,
+		Resolution: input.Resolution,
+		Outcome:    input.Outcome,
+		Value:      input.Value,
+		ProposedAt: now,
+	}
+	market.Status = entities.StatusProposed
+	market.UpdatedAt = now
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	return market, nil
+}
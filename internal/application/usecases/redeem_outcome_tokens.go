@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// RedeemOutcomeTokensUseCase pays out outcome tokens of a resolved market
+type RedeemOutcomeTokensUseCase struct {
+	marketRepo    repositories.MarketRepository
+	vault         services.Vault
+	outcomeTokens services.OutcomeTokens
+	pause         services.PauseState
+}
+
+// NewRedeemOutcomeTokensUseCase creates a new RedeemOutcomeTokensUseCase
+func NewRedeemOutcomeTokensUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	outcomeTokens services.OutcomeTokens,
+	pause services.PauseState,
+) *RedeemOutcomeTokensUseCase {
+	return &RedeemOutcomeTokensUseCase{
+		marketRepo:    marketRepo,
+		vault:         vault,
+		outcomeTokens: outcomeTokens,
+		pause:         pause,
+	}
+}
+
+// RedeemOutcomeTokensInput represents the input for redeeming outcome tokens
+type RedeemOutcomeTokensInput struct {
+	MarketID string
+	UserID   string
+	Outcome  uint8  // Outcome index of the tokens
+	Amount   uint64 // Tokens to burn
+}
+
+// Execute burns the user's outcome tokens and pays their value from the market vault.
+// Returns the collateral paid out.
+func (uc *RedeemOutcomeTokensUseCase) Execute(ctx context.Context, input RedeemOutcomeTokensInput) (uint64, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return 0, err
+	}
+
+	payout, err := services.RedeemValue(market, input.Outcome, input.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := services.ReleaseCollateral(market, payout); err != nil {
+		return 0, err
+	}
+
+	if err := uc.outcomeTokens.Burn(ctx, market, input.Outcome, input.UserID, input.Amount); err != nil {
+		return 0, err
+	}
+
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return 0, err
+	}
+
+	if err := uc.vault.Withdraw(ctx, market, input.UserID, payout); err != nil {
+		return 0, err
+	}
+
+	return payout, nil
+}
@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// RemoveLiquidityUseCase handles withdrawals from a constant-product pool
+type RemoveLiquidityUseCase struct {
+	marketRepo    repositories.MarketRepository
+	liquidityRepo repositories.LiquidityRepository
+	pool          services.LiquidityPool
+	vault         services.Vault
+}
+
+// NewRemoveLiquidityUseCase creates a new RemoveLiquidityUseCase
+func NewRemoveLiquidityUseCase(
+	marketRepo repositories.MarketRepository,
+	liquidityRepo repositories.LiquidityRepository,
+	pool services.LiquidityPool,
+	vault services.Vault,
+) *RemoveLiquidityUseCase {
+	return &RemoveLiquidityUseCase{
+		marketRepo:    marketRepo,
+		liquidityRepo: liquidityRepo,
+		pool:          pool,
+		vault:         vault,
+	}
+}
+
+// RemoveLiquidityInput represents the input for removing liquidity
+type RemoveLiquidityInput struct {
+	MarketID string
+	Provider string
+	LPShares uint64 // May be zero after resolution to only redeem leftover outcome shares
+}
+
+// RemoveLiquidityOutput describes what the provider receives
+type RemoveLiquidityOutput struct {
+	Collateral uint64 // Collateral base units from merged outcome shares
+	Fees       uint64 // Collateral base units of trading fees paid out
+	Redeemed   uint64 // Collateral base units paid for leftover outcome shares after resolution
+	Liquidity  *entities.LiquidityPosition
+}
+
+// Execute burns LP shares and pays out the provider's part of the pool and its earned fees.
+// Liquidity can be removed until the market's end date and again once it is
+// resolved, but not while the outcome is known and not yet settled. A pause or
+// freeze does not stop providers from leaving. Once the
+// market is resolved, the outcome shares left to the provider by unbalanced adds
+// and removes are redeemed as well.
+func (uc *RemoveLiquidityUseCase) Execute(ctx context.Context, input RemoveLiquidityInput) (*RemoveLiquidityOutput, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if market.PricingModel != entities.PricingConstantProduct {
+		return nil, services.ErrUnsupportedPricing
+	}
+
+	liquidity, err := uc.liquidityRepo.GetByMarketAndProvider(ctx, input.MarketID, input.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := market.Status == entities.StatusResolved
+	if !resolved && !time.Now().Before(market.EndDate) {
+		return nil, services.ErrTradingEnded
+	}
+	if input.LPShares > liquidity.LPShares || (input.LPShares == 0 && !resolved) {
+		return nil, services.ErrInvalidShares
+	}
+
+	if err := services.SettleFees(&market.Pool, liquidity); err != nil {
+		return nil, err
+	}
+
+	change := &services.LiquidityChange{}
+	if input.LPShares > 0 {
+		if change, err = uc.pool.RemoveLiquidity(market, input.LPShares); err != nil {
+			return nil, err
+		}
+	}
+
+	liquidity.LPShares -= change.LPShares
+	liquidity.YesShares += change.YesShares
+	liquidity.NoShares += change.NoShares
+	if err := services.ResetFeeDebt(&market.Pool, liquidity); err != nil {
+		return nil, err
+	}
+
+	// Pay out all settled fees, bounded by what the pool still holds
+	fees := liquidity.ClaimableFees
+	if fees > market.Pool.Fees {
+		fees = market.Pool.Fees
+	}
+	market.Pool.Fees -= fees
+	liquidity.ClaimableFees -= fees
+
+	var redeemed uint64
+	if resolved {
+		if redeemed, err = services.RedeemLiquidityShares(market, liquidity); err != nil {
+			return nil, err
+		}
+	}
+
+	paid := change.Collateral + fees + redeemed
+	if paid == 0 {
+		return nil, services.ErrNothingToClaim
+	}
+	if err := services.ReleaseCollateral(market, paid); err != nil {
+		return nil, err
+	}
+
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if err := uc.liquidityRepo.Update(ctx, liquidity); err != nil {
+		return nil, err
+	}
+
+	if err := uc.vault.Withdraw(ctx, market, input.Provider, paid); err != nil {
+		return nil, err
+	}
+
+	return &RemoveLiquidityOutput{
+		Collateral: change.Collateral,
+		Fees:       fees,
+		Redeemed:   redeemed,
+		Liquidity:  liquidity,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func (f *fixture) addLiquidity(t *testing.T, marketID, provider string, amount uint64) *entities.LiquidityPosition {
+	t.Helper()
+
+	uc := NewAddLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault, f.pause)
+	liquidity, err := uc.Execute(context.Background(), AddLiquidityInput{MarketID: marketID, Provider: provider, Amount: amount})
+	if err != nil {
+		t.Fatalf("add liquidity: %v", err)
+	}
+	return liquidity
+}
+
+// TestRemoveLiquidity checks that providers leaving a traded pool take out its
+// reserves and every fee it earned, leaving it empty
+func TestRemoveLiquidity(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	input := marketInput(1)
+	input.PricingModel = entities.PricingConstantProduct
+	input.Liquidity = 0
+	input.FeeBps = 200
+	market := f.createMarket(t, input)
+
+	f.addLiquidity(t, market.ID, alice, 10_000_000)
+	f.mustBuy(t, market.ID, carol, entities.SideYesValue, 3_000_000)
+	// The pool is unbalanced now, so bob is left with outcome shares
+	bobLiquidity := f.addLiquidity(t, market.ID, bob, 1_000_000)
+	if bobLiquidity.YesShares == 0 && bobLiquidity.NoShares == 0 {
+		t.Fatal("unbalanced add left bob no outcome shares")
+	}
+	f.mustBuy(t, market.ID, alice, entities.SideNoValue, 500_000)
+	f.checkSolvency(t, market.ID)
+
+	remove := NewRemoveLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault)
+	var fees uint64
+	for _, provider := range []string{alice, bob} {
+		liquidity, err := f.liquidity.GetByMarketAndProvider(ctx, market.ID, provider)
+		if err != nil {
+			t.Fatalf("get liquidity: %v", err)
+		}
+		output, err := remove.Execute(ctx, RemoveLiquidityInput{MarketID: market.ID, Provider: provider, LPShares: liquidity.LPShares})
+		if err != nil {
+			t.Fatalf("remove liquidity: %v", err)
+		}
+		if output.Liquidity.LPShares != 0 {
+			t.Fatalf("provider keeps %d LP shares after removing everything", output.Liquidity.LPShares)
+		}
+		fees += output.Fees
+		f.checkSolvency(t, market.ID)
+	}
+
+	pool := f.market(t, market.ID).Pool
+	if pool.LPSupply != 0 || pool.YesReserve != 0 || pool.NoReserve != 0 {
+		t.Fatalf("pool = %+v after every provider left, want it empty", pool)
+	}
+	if fees == 0 || pool.Fees != 0 {
+		t.Fatalf("providers were paid %d in fees with %d left in the pool", fees, pool.Fees)
+	}
+}
+
+// TestRemoveLiquiditySolvency checks that a constant-product market pays every
+// trader and liquidity provider, leftover outcome shares included, whichever
+// way it resolves
+func TestRemoveLiquiditySolvency(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolution entities.MarketResolution
+	}{
+		{"yes", entities.ResolutionYes},
+		{"no", entities.ResolutionNo},
+		{"cancelled", entities.ResolutionCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			input := marketInput(1)
+			input.PricingModel = entities.PricingConstantProduct
+			input.Liquidity = 0
+			input.FeeBps = 200
+			market := f.createMarket(t, input)
+
+			f.addLiquidity(t, market.ID, alice, 10_000_000)
+			f.mustBuy(t, market.ID, carol, entities.SideYesValue, 3_000_000)
+			// The pool is unbalanced now, so bob is left with outcome shares
+			bobLiquidity := f.addLiquidity(t, market.ID, bob, 1_000_000)
+			if bobLiquidity.YesShares == 0 && bobLiquidity.NoShares == 0 {
+				t.Fatal("unbalanced add left bob no outcome shares")
+			}
+			f.mustBuy(t, market.ID, carol, entities.SideYesValue, 500_000)
+			f.checkSolvency(t, market.ID)
+
+			f.resolve(t, market.ID, tt.resolution)
+
+			claim := NewClaimWinningsUseCase(f.positions, f.markets, f.vault, f.pause)
+			if _, err := claim.Execute(ctx, ClaimWinningsInput{MarketID: market.ID, UserID: carol}); err != nil && !errors.Is(err, services.ErrNothingToClaim) {
+				t.Fatalf("claim: %v", err)
+			}
+
+			remove := NewRemoveLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault)
+			for _, provider := range []string{alice, bob} {
+				liquidity, err := f.liquidity.GetByMarketAndProvider(ctx, market.ID, provider)
+				if err != nil {
+					t.Fatalf("get liquidity: %v", err)
+				}
+				output, err := remove.Execute(ctx, RemoveLiquidityInput{MarketID: market.ID, Provider: provider, LPShares: liquidity.LPShares})
+				if err != nil {
+					t.Fatalf("remove liquidity: %v", err)
+				}
+				if output.Liquidity.YesShares != 0 || output.Liquidity.NoShares != 0 || output.Liquidity.LPShares != 0 {
+					t.Fatalf("provider keeps %+v after removing everything", output.Liquidity)
+				}
+				f.checkSolvency(t, market.ID)
+			}
+
+			if pool := f.market(t, market.ID).Pool; pool.LPSupply != 0 {
+				t.Fatalf("LP supply = %d after every provider left", pool.LPSupply)
+			}
+		})
+	}
+}
+
+func TestRemoveLiquidityRedeemsLeftoverShares(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolved bool
+		wantErr  error
+	}{
+		{"after resolution", true, nil},
+		{"before resolution", false, services.ErrInvalidShares},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			input := marketInput(1)
+			input.PricingModel = entities.PricingConstantProduct
+			input.Liquidity = 0
+			market := f.createMarket(t, input)
+
+			f.addLiquidity(t, market.ID, alice, 10_000_000)
+			f.mustBuy(t, market.ID, carol, entities.SideYesValue, 3_000_000)
+			bobLiquidity := f.addLiquidity(t, market.ID, bob, 1_000_000)
+			if tt.resolved {
+				f.resolve(t, market.ID, entities.ResolutionYes)
+			}
+
+			// Zero LP shares only redeems the leftover outcome shares
+			remove := NewRemoveLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault)
+			output, err := remove.Execute(context.Background(), RemoveLiquidityInput{MarketID: market.ID, Provider: bob})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("remove error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if output.Redeemed != bobLiquidity.YesShares || output.Liquidity.LPShares != bobLiquidity.LPShares {
+				t.Fatalf("redeemed %d keeping %d LP shares, want %d keeping %d", output.Redeemed, output.Liquidity.LPShares, bobLiquidity.YesShares, bobLiquidity.LPShares)
+			}
+			f.checkSolvency(t, market.ID)
+		})
+	}
+}
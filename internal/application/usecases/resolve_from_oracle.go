@@ -0,0 +1,116 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ResolveFromOracleUseCase handles resolving oracle-backed markets
+type ResolveFromOracleUseCase struct {
+	marketRepo    repositories.MarketRepository
+	oracleRepo    repositories.OracleRepository
+	marketService services.MarketService
+}
+
+// NewResolveFromOracleUseCase creates a new ResolveFromOracleUseCase
+func NewResolveFromOracleUseCase(
+	marketRepo repositories.MarketRepository,
+	oracleRepo repositories.OracleRepository,
+	marketService services.MarketService,
+) *ResolveFromOracleUseCase {
+	return &ResolveFromOracleUseCase{
+		marketRepo:    marketRepo,
+		oracleRepo:    oracleRepo,
+		marketService: marketService,
+	}
+}
+
+// ResolveFromOracleInput represents the input for resolving a market from its oracle
+type ResolveFromOracleInput struct {
+	MarketID string
+	// Signers are the keys that signed the instruction
+	Signers []string
+	// PriceUpdate is the account holding the price a price feed market resolves from
+	PriceUpdate string
+	// Resolution, Outcome and Value are the resolution reported by a resolver key
+	// or committee; they are ignored for price feeds, whose resolution is derived
+	Resolution entities.MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+}
+
+// Execute resolves an oracle-backed market from its oracle:
+//   - a resolver key resolves the market when it signs the instruction
+//   - a price feed market can be resolved by anyone, at any time, from the first price its feed
+//     published at or after the market's end date
+//   - a committee resolves the market when at least its threshold of members sign the instruction
+func (uc *ResolveFromOracleUseCase) Execute(ctx context.Context, input ResolveFromOracleInput) (*services.OracleReport, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !market.IsOracleBacked() {
+		return nil, services.ErrNotOracleMarket
+	}
+
+	report := &services.OracleReport{
+		Resolution: input.Resolution,
+		Outcome:    input.Outcome,
+		Value:      input.Value,
+	}
+
+	switch market.Oracle.Kind {
+	case entities.OracleResolver:
+		if !containsKey(input.Signers, market.Oracle.Account) {
+			return nil, services.ErrUnauthorized
+		}
+	case entities.OraclePriceFeed:
+		feed, err := uc.oracleRepo.GetPriceFeed(ctx, input.PriceUpdate)
+		if err != nil {
+			return nil, err
+		}
+		if report, err = services.ReportFromPriceFeed(market, feed); err != nil {
+			return nil, err
+		}
+	case entities.OracleCommittee:
+		committee, err := uc.oracleRepo.GetCommittee(ctx, market.Oracle.Account)
+		if err != nil {
+			return nil, err
+		}
+		if err := services.CheckCommitteeQuorum(committee, input.Signers); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, entities.ErrInvalidOracleKind
+	}
+
+	if !report.Resolution.IsFinal() {
+		return nil, entities.ErrInvalidResolution
+	}
+
+	resolver := market.Oracle.Account
+	if report.Resolution == entities.ResolutionScalar {
+		err = uc.marketService.ResolveScalar(ctx, market.ID, report.Value, resolver)
+	} else {
+		err = uc.marketService.ResolveMarket(ctx, market.ID, report.Resolution, report.Outcome, resolver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// containsKey reports whether key is one of keys
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
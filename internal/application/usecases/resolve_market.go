@@ -11,16 +11,19 @@ import (
 type ResolveMarketUseCase struct {
 	marketRepo   repositories.MarketRepository
 	marketService services.MarketService
+	roles         services.Roles
 }
 
 // NewResolveMarketUseCase creates a new ResolveMarketUseCase
 func NewResolveMarketUseCase(
 	marketRepo repositories.MarketRepository,
 	marketService services.MarketService,
+	roles services.Roles,
 ) *ResolveMarketUseCase {
 	return &ResolveMarketUseCase{
 		marketRepo:   marketRepo,
 		marketService: marketService,
+		roles:         roles,
 	}
 }
 
@@ -28,21 +31,35 @@ func NewResolveMarketUseCase(
 type ResolveMarketInput struct {
 	MarketID   string
 	Resolution entities.MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
 	Resolver   string
 }
 
 // Execute resolves a market
 func (uc *ResolveMarketUseCase) Execute(ctx context.Context, input ResolveMarketInput) error {
+	if !input.Resolution.IsFinal() {
+		return entities.ErrInvalidResolution
+	}
+
 	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
 	if err != nil {
 		return err
 	}
 
-	// Check if resolver is authorized (could be creator or admin)
-	if market.Creator != input.Resolver {
-		return services.ErrUnauthorized
+	// Oracle-backed markets are only resolved through ResolveFromOracle
+	if market.IsOracleBacked() {
+		return services.ErrOracleMarket
+	}
+	// Optimistic markets are only resolved through bonded proposals
+	if market.IsOptimistic() {
+		return services.ErrOptimisticMarket
+	}
+
+	// The creator or a holder of the resolver role resolves
+	if err := services.RequireMarketRole(ctx, uc.roles, market, input.Resolver, entities.RoleResolver); err != nil {
+		return err
 	}
 
-	return uc.marketService.ResolveMarket(ctx, input.MarketID, input.Resolution, input.Resolver)
+	return uc.marketService.ResolveMarket(ctx, input.MarketID, input.Resolution, input.Outcome, input.Resolver)
 }
 
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ResolveScalarUseCase handles resolving scalar markets to a numeric value
+type ResolveScalarUseCase struct {
+	marketRepo    repositories.MarketRepository
+	marketService services.MarketService
+	roles         services.Roles
+}
+
+// NewResolveScalarUseCase creates a new ResolveScalarUseCase
+func NewResolveScalarUseCase(
+	marketRepo repositories.MarketRepository,
+	marketService services.MarketService,
+	roles services.Roles,
+) *ResolveScalarUseCase {
+	return &ResolveScalarUseCase{
+		marketRepo:    marketRepo,
+		marketService: marketService,
+		roles:         roles,
+	}
+}
+
+// ResolveScalarInput represents the input for resolving a scalar market
+type ResolveScalarInput struct {
+	MarketID string
+	Value    int64 // Resolved value, clamped to the market's range at payout
+	Resolver string
+}
+
+// Execute resolves a scalar market
+func (uc *ResolveScalarUseCase) Execute(ctx context.Context, input ResolveScalarInput) error {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return err
+	}
+
+	// Oracle-backed markets are only resolved through ResolveFromOracle
+	if market.IsOracleBacked() {
+		return services.ErrOracleMarket
+	}
+	// Optimistic markets are only resolved through bonded proposals
+	if market.IsOptimistic() {
+		return services.ErrOptimisticMarket
+	}
+
+	// The creator or a holder of the resolver role resolves, as for other markets
+	if err := services.RequireMarketRole(ctx, uc.roles, market, input.Resolver, entities.RoleResolver); err != nil {
+		return err
+	}
+
+	return uc.marketService.ResolveScalar(ctx, input.MarketID, input.Value, input.Resolver)
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+)
+
+// RevokeRoleUseCase handles revoking a role in the program config
+type RevokeRoleUseCase struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewRevokeRoleUseCase creates a new RevokeRoleUseCase
+func NewRevokeRoleUseCase(configRepo repositories.ConfigRepository) *RevokeRoleUseCase {
+	return &RevokeRoleUseCase{
+		configRepo: configRepo,
+	}
+}
+
+// RevokeRoleInput represents the input for revoking a role
+type RevokeRoleInput struct {
+	Caller string
+	Role   entities.Role
+	Key    string
+}
+
+// Execute revokes Role from Key.
+// Only the admin can change roles, and the admin role itself only changes
+// through TransferAdmin.
+func (uc *RevokeRoleUseCase) Execute(ctx context.Context, input RevokeRoleInput) (*entities.ProgramConfig, error) {
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckRole(config, input.Caller, entities.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := solanautils.PublicKeyFromString(input.Key); err != nil {
+		return nil, err
+	}
+
+	if err := config.Revoke(input.Role, input.Key); err != nil {
+		return nil, err
+	}
+
+	if err := uc.configRepo.Update(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
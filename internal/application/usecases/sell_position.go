@@ -0,0 +1,141 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// SellPositionUseCase handles selling shares back to the market maker
+type SellPositionUseCase struct {
+	positionRepo  repositories.PositionRepository
+	marketRepo    repositories.MarketRepository
+	pricingEngine services.PricingEngine
+	vault         services.Vault
+	feeVault      services.FeeVault
+	outcomeTokens services.OutcomeTokens
+	pause         services.PauseState
+}
+
+// NewSellPositionUseCase creates a new SellPositionUseCase
+func NewSellPositionUseCase(
+	positionRepo repositories.PositionRepository,
+	marketRepo repositories.MarketRepository,
+	pricingEngine services.PricingEngine,
+	vault services.Vault,
+	feeVault services.FeeVault,
+	outcomeTokens services.OutcomeTokens,
+	pause services.PauseState,
+) *SellPositionUseCase {
+	return &SellPositionUseCase{
+		positionRepo:  positionRepo,
+		marketRepo:    marketRepo,
+		pricingEngine: pricingEngine,
+		vault:         vault,
+		feeVault:      feeVault,
+		outcomeTokens: outcomeTokens,
+		pause:         pause,
+	}
+}
+
+// SellPositionInput represents the input for selling shares of a position
+type SellPositionInput struct {
+	MarketID string
+	UserID   string
+	Shares   uint64 // Share units to sell
+	Price    uint64 // Expected price per share in collateral base units
+	// MaxSlippageBps is how far below Price the average execution price may be
+	MaxSlippageBps uint16
+}
+
+// SellPositionOutput describes the executed sale
+type SellPositionOutput struct {
+	Proceeds    uint64 // Collateral paid to the seller, after fees
+	RealizedPnL int64  // Proceeds minus the cost basis of the sold shares
+	Position    *entities.Position
+}
+
+// Execute sells shares of the user's position in an open market at the market maker's
+// current price. Selling all shares closes the position.
+func (uc *SellPositionUseCase) Execute(ctx context.Context, input SellPositionInput) (*SellPositionOutput, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckTradingOpen(market, time.Now()); err != nil {
+		return nil, err
+	}
+
+	position, err := uc.positionRepo.GetByMarketAndUser(ctx, input.MarketID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Shares == 0 || input.Shares > position.AvailableShares() {
+		return nil, services.ErrInvalidShares
+	}
+
+	quote, err := uc.pricingEngine.Sell(market, position.Outcome, input.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckSellSlippage(quote, input.Price, input.MaxSlippageBps); err != nil {
+		return nil, err
+	}
+
+	// Protocol and creator fees are taken out of the proceeds
+	proceeds, fees, err := services.DeductFees(market, quote.Cost)
+	if err != nil {
+		return nil, err
+	}
+
+	// Release the cost basis of the sold shares and record the realised PnL
+	pnl, err := services.ReducePosition(position, input.Shares, proceeds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.ReleaseCollateral(market, quote.Cost); err != nil {
+		return nil, err
+	}
+	if err := services.AccrueFees(market, fees); err != nil {
+		return nil, err
+	}
+
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	if err := uc.positionRepo.Update(ctx, position); err != nil {
+		return nil, err
+	}
+
+	if market.OutcomeTokens {
+		if err := uc.outcomeTokens.Burn(ctx, market, position.Outcome, input.UserID, input.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := services.CollectFees(ctx, uc.feeVault, market, fees); err != nil {
+		return nil, err
+	}
+	if err := uc.vault.Withdraw(ctx, market, input.UserID, proceeds); err != nil {
+		return nil, err
+	}
+
+	return &SellPositionOutput{
+		Proceeds:    proceeds,
+		RealizedPnL: pnl,
+		Position:    position,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// SetPausedUseCase handles pausing and unpausing the protocol
+type SetPausedUseCase struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewSetPausedUseCase creates a new SetPausedUseCase
+func NewSetPausedUseCase(configRepo repositories.ConfigRepository) *SetPausedUseCase {
+	return &SetPausedUseCase{
+		configRepo: configRepo,
+	}
+}
+
+// SetPausedInput represents the input for pausing or unpausing the protocol
+type SetPausedInput struct {
+	Caller string
+	Paused bool
+}
+
+// Execute sets the protocol-wide pause. While paused, positions cannot be
+// opened or sold, orders cannot be placed, liquidity cannot be added, outcome
+// tokens cannot move, winnings and fees cannot be withdrawn and proposals cannot
+// be settled in any market. Orders can still be cancelled and liquidity removed.
+// Only holders of the pauser role can pause or unpause.
+func (uc *SetPausedUseCase) Execute(ctx context.Context, input SetPausedInput) (*entities.ProgramConfig, error) {
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckRole(config, input.Caller, entities.RolePauser); err != nil {
+		return nil, err
+	}
+
+	config.Paused = input.Paused
+	if err := uc.configRepo.Update(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
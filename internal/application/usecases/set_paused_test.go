@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func TestSetPaused(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  string
+		wantErr error
+	}{
+		{"pauser", pauser, nil},
+		{"admin", admin, nil},
+		{"creator", creator, services.ErrUnauthorized},
+		{"empty caller", "", services.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			market := f.createMarket(t, marketInput(1))
+
+			_, err := NewSetPausedUseCase(f.config).Execute(ctx, SetPausedInput{Caller: tt.caller, Paused: true})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("pause error = %v, want %v", err, tt.wantErr)
+			}
+
+			// Trading halts only once the protocol is paused
+			wantBuyErr := services.ErrProtocolPaused
+			if err != nil {
+				wantBuyErr = nil
+			}
+			if _, err := f.buy(market.ID, alice, entities.SideYesValue, 1_000); !errors.Is(err, wantBuyErr) {
+				t.Fatalf("buy error = %v, want %v", err, wantBuyErr)
+			}
+		})
+	}
+}
+
+func TestUnpauseResumesTrading(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	market := f.createMarket(t, marketInput(1))
+	uc := NewSetPausedUseCase(f.config)
+
+	if _, err := uc.Execute(ctx, SetPausedInput{Caller: pauser, Paused: true}); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if _, err := uc.Execute(ctx, SetPausedInput{Caller: pauser, Paused: false}); err != nil {
+		t.Fatalf("unpause: %v", err)
+	}
+	f.mustBuy(t, market.ID, alice, entities.SideYesValue, 1_000)
+}
+
+// TestPauseKeepsExitsOpen checks that a pause stops fee withdrawals and
+// proposal settlement but still lets users cancel orders and remove liquidity
+func TestPauseKeepsExitsOpen(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	market := f.createMarket(t, marketInput(1))
+	f.mustBuy(t, market.ID, alice, entities.SideYesValue, 1_000_000)
+	bid := f.placeOrder(t, PlaceOrderInput{MarketID: market.ID, UserID: bob, Outcome: entities.SideYesValue, Side: entities.OrderBuy, Price: 100_000_000, Size: 1_000, Nonce: 1})
+
+	input := marketInput(2)
+	input.PricingModel = entities.PricingConstantProduct
+	input.Liquidity = 0
+	pool := f.createMarket(t, input)
+	liquidity := f.addLiquidity(t, pool.ID, carol, 1_000_000)
+
+	if _, err := NewSetPausedUseCase(f.config).Execute(ctx, SetPausedInput{Caller: pauser, Paused: true}); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+
+	halted := []struct {
+		name string
+		run  func() error
+	}{
+		{"withdraw fees", func() error {
+			_, err := NewWithdrawFeesUseCase(f.markets, f.feeVault, f.roles, treasury, f.pause).Execute(ctx, WithdrawFeesInput{MarketID: market.ID, Recipient: entities.FeeRecipientProtocol, Caller: admin})
+			return err
+		}},
+		{"finalize resolution", func() error {
+			_, err := NewFinalizeResolutionUseCase(f.markets, f.vault, f.pause).Execute(ctx, FinalizeResolutionInput{MarketID: market.ID})
+			return err
+		}},
+		{"arbitrate resolution", func() error {
+			_, err := NewArbitrateResolutionUseCase(f.markets, f.vault, f.pause).Execute(ctx, ArbitrateResolutionInput{MarketID: market.ID, Arbiter: admin, Resolution: entities.ResolutionYes})
+			return err
+		}},
+	}
+	for _, tt := range halted {
+		if err := tt.run(); !errors.Is(err, services.ErrProtocolPaused) {
+			t.Fatalf("%s error = %v, want %v", tt.name, err, services.ErrProtocolPaused)
+		}
+	}
+
+	cancel := NewCancelOrderUseCase(f.orders, f.positions, f.markets, f.vault)
+	if _, err := cancel.Execute(ctx, CancelOrderInput{OrderID: bid.Order.ID, UserID: bob}); err != nil {
+		t.Fatalf("cancel order while paused: %v", err)
+	}
+	remove := NewRemoveLiquidityUseCase(f.markets, f.liquidity, services.NewConstantProduct(), f.vault)
+	if _, err := remove.Execute(ctx, RemoveLiquidityInput{MarketID: pool.ID, Provider: carol, LPShares: liquidity.LPShares}); err != nil {
+		t.Fatalf("remove liquidity while paused: %v", err)
+	}
+	f.checkSolvency(t, market.ID)
+	f.checkSolvency(t, pool.ID)
+}
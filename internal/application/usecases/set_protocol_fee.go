@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// SetProtocolFeeUseCase handles setting the protocol fee
+type SetProtocolFeeUseCase struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewSetProtocolFeeUseCase creates a new SetProtocolFeeUseCase
+func NewSetProtocolFeeUseCase(configRepo repositories.ConfigRepository) *SetProtocolFeeUseCase {
+	return &SetProtocolFeeUseCase{
+		configRepo: configRepo,
+	}
+}
+
+// SetProtocolFeeInput represents the input for setting the protocol fee
+type SetProtocolFeeInput struct {
+	Caller         string
+	ProtocolFeeBps uint16
+}
+
+// Execute sets the protocol fee charged by markets created from now on.
+// Markets keep the fee they were created with. Only the admin can set it.
+func (uc *SetProtocolFeeUseCase) Execute(ctx context.Context, input SetProtocolFeeInput) (*entities.ProgramConfig, error) {
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckRole(config, input.Caller, entities.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := services.ValidateProtocolFee(input.ProtocolFeeBps); err != nil {
+		return nil, err
+	}
+
+	config.ProtocolFeeBps = input.ProtocolFeeBps
+	if err := uc.configRepo.Update(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
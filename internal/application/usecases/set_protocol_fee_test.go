@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func TestSetProtocolFee(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  string
+		feeBps  uint16
+		wantErr error
+	}{
+		{"admin", admin, 200, nil},
+		{"admin removes the fee", admin, 0, nil},
+		{"admin at the cap", admin, services.MaxMarketFeeBps, nil},
+		{"above the cap", admin, services.MaxMarketFeeBps + 1, services.ErrFeeTooHigh},
+		{"pauser", pauser, 200, services.ErrUnauthorized},
+		{"creator", creator, 200, services.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			before := f.createMarket(t, marketInput(1))
+
+			_, err := NewSetProtocolFeeUseCase(f.config).Execute(ctx, SetProtocolFeeInput{Caller: tt.caller, ProtocolFeeBps: tt.feeBps})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("set protocol fee error = %v, want %v", err, tt.wantErr)
+			}
+
+			// New markets take the configured fee; existing markets keep theirs
+			wantFee := uint16(100)
+			if err == nil {
+				wantFee = tt.feeBps
+			}
+			input := marketInput(2)
+			input.CreatorFeeBps = 0
+			if got := f.createMarket(t, input).ProtocolFeeBps; got != wantFee {
+				t.Fatalf("new market protocol fee = %d, want %d", got, wantFee)
+			}
+			if got := f.market(t, before.ID).ProtocolFeeBps; got != 100 {
+				t.Fatalf("existing market protocol fee = %d, want 100", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// SplitCollateralUseCase turns collateral into complete sets of outcome tokens
+type SplitCollateralUseCase struct {
+	marketRepo    repositories.MarketRepository
+	vault         services.Vault
+	outcomeTokens services.OutcomeTokens
+	pause         services.PauseState
+}
+
+// NewSplitCollateralUseCase creates a new SplitCollateralUseCase
+func NewSplitCollateralUseCase(
+	marketRepo repositories.MarketRepository,
+	vault services.Vault,
+	outcomeTokens services.OutcomeTokens,
+	pause services.PauseState,
+) *SplitCollateralUseCase {
+	return &SplitCollateralUseCase{
+		marketRepo:    marketRepo,
+		vault:         vault,
+		outcomeTokens: outcomeTokens,
+		pause:         pause,
+	}
+}
+
+// SplitCollateralInput represents the input for splitting collateral
+type SplitCollateralInput struct {
+	MarketID string
+	UserID   string
+	Amount   uint64 // Collateral base units, each minting 1 token of every outcome
+}
+
+// Execute escrows the collateral and mints Amount tokens of every outcome to the user
+func (uc *SplitCollateralUseCase) Execute(ctx context.Context, input SplitCollateralInput) error {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return err
+	}
+
+	if !market.OutcomeTokens {
+		return services.ErrOutcomeTokensDisabled
+	}
+	if market.Status == entities.StatusResolved {
+		return services.ErrInvalidMarketStatus
+	}
+	if input.Amount == 0 {
+		return services.ErrInvalidShares
+	}
+
+	if err := services.LockCollateral(market, input.Amount); err != nil {
+		return err
+	}
+	if err := uc.vault.Deposit(ctx, market, input.UserID, input.Amount); err != nil {
+		return err
+	}
+
+	for outcome := 0; outcome < market.OutcomeCount(); outcome++ {
+		if err := uc.outcomeTokens.Mint(ctx, market, uint8(outcome), input.UserID, input.Amount); err != nil {
+			return err
+		}
+	}
+
+	market.UpdatedAt = time.Now()
+	return uc.marketRepo.Update(ctx, market)
+}
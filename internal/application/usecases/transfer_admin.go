@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+)
+
+// TransferAdminUseCase handles starting an admin transfer
+type TransferAdminUseCase struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewTransferAdminUseCase creates a new TransferAdminUseCase
+func NewTransferAdminUseCase(configRepo repositories.ConfigRepository) *TransferAdminUseCase {
+	return &TransferAdminUseCase{
+		configRepo: configRepo,
+	}
+}
+
+// TransferAdminInput represents the input for transferring the admin role
+type TransferAdminInput struct {
+	Caller   string
+	NewAdmin string
+}
+
+// Execute nominates NewAdmin as the next admin. The current admin keeps the
+// role until NewAdmin accepts it with AcceptAdmin, so a mistyped key cannot
+// lock the program out. A new nomination replaces a pending one.
+func (uc *TransferAdminUseCase) Execute(ctx context.Context, input TransferAdminInput) (*entities.ProgramConfig, error) {
+	config, err := uc.configRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.CheckRole(config, input.Caller, entities.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := solanautils.PublicKeyFromString(input.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	config.PendingAdmin = input.NewAdmin
+	if err := uc.configRepo.Update(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+	"github.com/polymarket/solana-program/pkg/utils"
+)
+
+// VoteResolutionUseCase handles committee members voting on the resolution of committee-resolved markets
+type VoteResolutionUseCase struct {
+	marketRepo    repositories.MarketRepository
+	committeeRepo repositories.CommitteeRepository
+	voteRepo      repositories.VoteRepository
+	marketService services.MarketService
+}
+
+// NewVoteResolutionUseCase creates a new VoteResolutionUseCase
+func NewVoteResolutionUseCase(
+	marketRepo repositories.MarketRepository,
+	committeeRepo repositories.CommitteeRepository,
+	voteRepo repositories.VoteRepository,
+	marketService services.MarketService,
+) *VoteResolutionUseCase {
+	return &VoteResolutionUseCase{
+		marketRepo:    marketRepo,
+		committeeRepo: committeeRepo,
+		voteRepo:      voteRepo,
+		marketService: marketService,
+	}
+}
+
+// VoteResolutionInput represents the input for voting on a market's resolution
+type VoteResolutionInput struct {
+	MarketID   string
+	Voter      string
+	Resolution entities.MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+}
+
+// VoteResolutionOutput reports the recorded vote and the market's tallies after it
+type VoteResolutionOutput struct {
+	Vote     *entities.Vote
+	Tallies  []entities.VoteTally
+	Resolved bool // Whether the vote brought a resolution to the committee's threshold
+}
+
+// Execute records a committee member's vote on a committee-resolved market,
+// replacing the member's previous vote. Once the committee's threshold of
+// members agree on one resolution the market is resolved to it.
+func (uc *VoteResolutionUseCase) Execute(ctx context.Context, input VoteResolutionInput) (*VoteResolutionOutput, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !market.IsOracleBacked() || market.Oracle.Kind != entities.OracleCommittee {
+		return nil, services.ErrNotCommitteeMarket
+	}
+	if market.Status != entities.StatusOpen && market.Status != entities.StatusClosed {
+		return nil, services.ErrInvalidMarketStatus
+	}
+
+	committee, err := uc.committeeRepo.GetByAddress(ctx, market.Oracle.Account)
+	if err != nil {
+		return nil, err
+	}
+	if err := committee.Validate(); err != nil {
+		return nil, err
+	}
+	if !committee.IsMember(input.Voter) {
+		return nil, services.ErrNotCommitteeMember
+	}
+
+	if !input.Resolution.IsFinal() {
+		return nil, entities.ErrInvalidResolution
+	}
+	if err := services.ValidateReportedResolution(market, input.Resolution, input.Outcome); err != nil {
+		return nil, err
+	}
+
+	vote, isNew, err := uc.getOrNew(ctx, market.ID, input.Voter)
+	if err != nil {
+		return nil, err
+	}
+	vote.Resolution = input.Resolution
+	vote.Outcome = input.Outcome
+	vote.Value = input.Value
+	vote.VotedAt = time.Now()
+
+	if isNew {
+		err = uc.voteRepo.Create(ctx, vote)
+	} else {
+		err = uc.voteRepo.Update(ctx, vote)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := uc.voteRepo.GetByMarketID(ctx, market.ID)
+	if err != nil {
+		return nil, err
+	}
+	output := &VoteResolutionOutput{
+		Vote:    vote,
+		Tallies: services.TallyVotes(committee, votes),
+	}
+
+	quorum := services.QuorumTally(committee, output.Tallies)
+	if quorum == nil {
+		return output, nil
+	}
+
+	resolver := committee.Address
+	if quorum.Resolution == entities.ResolutionScalar {
+		err = uc.marketService.ResolveScalar(ctx, market.ID, quorum.Value, resolver)
+	} else {
+		err = uc.marketService.ResolveMarket(ctx, market.ID, quorum.Resolution, quorum.Outcome, resolver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	output.Resolved = true
+
+	return output, nil
+}
+
+func (uc *VoteResolutionUseCase) getOrNew(ctx context.Context, marketID, voter string) (*entities.Vote, bool, error) {
+	vote, err := uc.voteRepo.GetByMarketAndVoter(ctx, marketID, voter)
+	if err == nil {
+		return vote, false, nil
+	}
+	if !errors.Is(err, repositories.ErrVoteNotFound) {
+		return nil, false, err
+	}
+
+	id, err := DeriveVoteID(marketID, voter)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &entities.Vote{
+		ID:       id,
+		MarketID: marketID,
+		Voter:    voter,
+	}, true, nil
+}
+
+// DeriveVoteID derives the vote ID from the market and voter,
+// matching the one vote account per member per market PDA layout
+func DeriveVoteID(marketID, voter string) (string, error) {
+	if err := utils.ValidateID(marketID); err != nil {
+		return "", err
+	}
+
+	voterKey, err := solanautils.PublicKeyFromString(voter)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.DeriveID([]byte("vote"), []byte(marketID), voterKey[:]), nil
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// WithdrawFeesUseCase handles paying out the trading fees collected by a market
+type WithdrawFeesUseCase struct {
+	marketRepo repositories.MarketRepository
+	feeVault   services.FeeVault
+	roles      services.Roles
+	treasury   string // Public key of the protocol treasury
+	pause      services.PauseState
+}
+
+// NewWithdrawFeesUseCase creates a new WithdrawFeesUseCase
+func NewWithdrawFeesUseCase(
+	marketRepo repositories.MarketRepository,
+	feeVault services.FeeVault,
+	roles services.Roles,
+	treasury string,
+	pause services.PauseState,
+) *WithdrawFeesUseCase {
+	return &WithdrawFeesUseCase{
+		marketRepo: marketRepo,
+		feeVault:   feeVault,
+		roles:      roles,
+		treasury:   treasury,
+		pause:      pause,
+	}
+}
+
+// WithdrawFeesInput represents the input for withdrawing fees
+type WithdrawFeesInput struct {
+	MarketID  string
+	Recipient entities.FeeRecipient
+	Caller    string
+}
+
+// Execute pays all fees accrued for the recipient to its owner. Protocol fees
+// are withdrawn by the admin and paid to the protocol treasury; creator fees are
+// withdrawn by and paid to the market's creator. Fees can be withdrawn in any
+// market status, but not while the protocol is paused or the market frozen.
+// Returns the collateral base units paid out.
+func (uc *WithdrawFeesUseCase) Execute(ctx context.Context, input WithdrawFeesInput) (uint64, error) {
+	market, err := uc.marketRepo.GetByID(ctx, input.MarketID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := services.CheckNotHalted(ctx, uc.pause, market); err != nil {
+		return 0, err
+	}
+
+	owner := uc.treasury
+	if input.Recipient == entities.FeeRecipientCreator {
+		owner = market.Creator
+		if input.Caller != owner {
+			return 0, services.ErrUnauthorized
+		}
+	} else if err := uc.roles.Require(ctx, input.Caller, entities.RoleAdmin); err != nil {
+		return 0, err
+	}
+
+	amount := market.AccruedFees(input.Recipient)
+	if amount == 0 {
+		return 0, services.ErrNoFees
+	}
+
+	// Zero the accrued fees first; the version check rejects a concurrent
+	// withdrawal of the same fees before any collateral moves
+	if input.Recipient == entities.FeeRecipientCreator {
+		market.CreatorFees = 0
+	} else {
+		market.ProtocolFees = 0
+	}
+	market.UpdatedAt = time.Now()
+	if err := uc.marketRepo.Update(ctx, market); err != nil {
+		return 0, err
+	}
+
+	if err := uc.feeVault.Withdraw(ctx, market, input.Recipient, owner, amount); err != nil {
+		return 0, err
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+const treasury = "treasury"
+
+func TestWithdrawFees(t *testing.T) {
+	tests := []struct {
+		name        string
+		recipient   entities.FeeRecipient
+		caller      string
+		destination string
+		wantErr     error
+	}{
+		{"protocol fees by the admin", entities.FeeRecipientProtocol, admin, treasury, nil},
+		{"creator fees by the creator", entities.FeeRecipientCreator, creator, creator, nil},
+		{"protocol fees by the creator", entities.FeeRecipientProtocol, creator, "", services.ErrUnauthorized},
+		{"creator fees by the admin", entities.FeeRecipientCreator, admin, "", services.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+			market := f.createMarket(t, marketInput(1))
+			f.mustBuy(t, market.ID, alice, entities.SideYesValue, 5_000_000)
+			accrued := f.market(t, market.ID).AccruedFees(tt.recipient)
+
+			// The fees must already be zeroed and stored when they leave the fee vault
+			f.feeVault.onWithdraw = func(*entities.Market) {
+				if fees := f.market(t, market.ID).AccruedFees(tt.recipient); fees != 0 {
+					t.Fatalf("fee vault paid out while %d fees were still recorded", fees)
+				}
+			}
+
+			uc := NewWithdrawFeesUseCase(f.markets, f.feeVault, f.roles, treasury, f.pause)
+			input := WithdrawFeesInput{MarketID: market.ID, Recipient: tt.recipient, Caller: tt.caller}
+			paid, err := uc.Execute(ctx, input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("withdraw error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if paid != accrued || paid == 0 || f.feeVault.withdrawn[tt.destination] != paid {
+				t.Fatalf("withdrew %d to %s, want the %d accrued", f.feeVault.withdrawn[tt.destination], tt.destination, accrued)
+			}
+
+			if _, err := uc.Execute(ctx, input); !errors.Is(err, services.ErrNoFees) {
+				t.Fatalf("second withdraw error = %v, want %v", err, services.ErrNoFees)
+			}
+		})
+	}
+}
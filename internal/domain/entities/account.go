@@ -15,20 +15,119 @@ type Account struct {
 
 // MarketAccount represents the on-chain state of a market
 type MarketAccount struct {
-	MarketID   string
-	Title      string
-	EndDate    int64
-	Status     uint8
-	Resolution uint8
-	Creator    [32]byte
+	MarketID           string
+	Title              string
+	EndDate            int64
+	Status             uint8
+	Resolution         uint8
+	ResolvedOutcome    uint8
+	ResolvedValue      int64
+	Creator            [32]byte
+	Liquidity          uint64
+	YesShares          uint64
+	NoShares           uint64
+	Outcomes           []string
+	OutcomeShares      []uint64
+	Scalar             bool
+	ScalarMin          int64
+	ScalarMax          int64
+	PricingModel       uint8
+	YesReserve         uint64
+	NoReserve          uint64
+	LPSupply           uint64
+	PoolFeeBps         uint16
+	AccFeePerShare     uint64
+	PoolFees           uint64
+	Collateral         uint64
+	CollateralMint     [32]byte // All zeros for native SOL
+	CollateralDecimals uint8
+	OutcomeTokens      bool
+	OrderSequence      uint64
+	ProtocolFeeBps     uint16
+	CreatorFeeBps      uint16
+	ProtocolFees       uint64
+	CreatorFees        uint64
+	OracleKind         uint8 // OracleNoneValue for markets resolved by their creator
+	OracleAccount      [32]byte
+	OracleThreshold    int64
+	OracleExponent     int32
+	Optimistic         bool
+	ProposalBond       uint64
+	DisputeWindow      int64 // Seconds
+	Arbiter            [32]byte
+	HasProposal        bool
+	Proposer           [32]byte
+	ProposedResolution uint8
+	ProposedOutcome    uint8
+	ProposedValue      int64
+	ProposedAt         int64
+	Disputer           [32]byte // All zeros until disputed
+	DisputedAt         int64
+	Frozen             bool
+}
+
+// CommitteeAccount represents the on-chain state of a resolver committee
+type CommitteeAccount struct {
+	Members   [][32]byte
+	Threshold uint8
 }
 
 // PositionAccount represents the on-chain state of a position
 type PositionAccount struct {
+	MarketID    string
+	UserID      [32]byte
+	Outcome     uint8
+	Amount      uint64
+	Shares      uint64
+	Price       uint64
+	Locked      uint64
+	RealizedPnL int64
+	Claimed     bool
+	CostBasis   uint64
+}
+
+// LiquidityAccount represents the on-chain state of a liquidity position
+type LiquidityAccount struct {
+	MarketID      string
+	Provider      [32]byte
+	LPShares      uint64
+	YesShares     uint64
+	NoShares      uint64
+	FeeDebt       uint64
+	ClaimableFees uint64
+}
+
+// OrderAccount represents the on-chain state of a limit order
+type OrderAccount struct {
+	OrderID  string
 	MarketID string
 	UserID   [32]byte
+	Outcome  uint8
 	Side     uint8
-	Amount   uint64
 	Price    uint64
+	Size     uint64
+	Filled   uint64
+	Escrow   uint64
+	Status   uint8
+	Sequence uint64
+}
+
+// VoteAccount represents the on-chain state of a committee member's vote on a market
+type VoteAccount struct {
+	MarketID   string
+	Voter      [32]byte
+	Resolution uint8
+	Outcome    uint8
+	Value      int64
+	VotedAt    int64
 }
 
+// ConfigAccount represents the on-chain state of the program config
+type ConfigAccount struct {
+	Admin          [32]byte
+	PendingAdmin   [32]byte // All zeros when no transfer is pending
+	Resolvers      [][32]byte
+	Pausers        [][32]byte
+	Paused         bool
+	ProtocolFeeBps uint16
+}
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidCommittee = errors.New("committee threshold must be between 1 and its member count")
+)
+
+// MaxCommitteeMembers is the maximum number of members of a resolver committee
+const MaxCommitteeMembers = 16
+
+// Committee is a group of keys resolving markets together: Threshold of its
+// Members must agree on a resolution
+type Committee struct {
+	Address   string   // Public key of the committee account
+	Members   []string // Public keys of the members
+	Threshold uint8
+}
+
+// Validate rejects committees that could never or too easily reach their threshold
+func (c *Committee) Validate() error {
+	if len(c.Members) == 0 || len(c.Members) > MaxCommitteeMembers {
+		return ErrInvalidCommittee
+	}
+	if c.Threshold == 0 || int(c.Threshold) > len(c.Members) {
+		return ErrInvalidCommittee
+	}
+	seen := make(map[string]bool, len(c.Members))
+	for _, member := range c.Members {
+		if member == "" || seen[member] {
+			return ErrInvalidCommittee
+		}
+		seen[member] = true
+	}
+	return nil
+}
+
+// IsMember reports whether key belongs to the committee
+func (c *Committee) IsMember(key string) bool {
+	for _, member := range c.Members {
+		if member == key {
+			return true
+		}
+	}
+	return false
+}
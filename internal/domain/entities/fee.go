@@ -0,0 +1,49 @@
+package entities
+
+import "errors"
+
+var (
+	ErrInvalidFeeRecipient = errors.New("invalid fee recipient")
+)
+
+// FeeRecipient identifies who a market's fee vault collects trading fees for
+type FeeRecipient string
+
+const (
+	FeeRecipientProtocol FeeRecipient = "protocol"
+	FeeRecipientCreator  FeeRecipient = "creator"
+)
+
+// FeeRecipient wire values, shared by instructions and PDA seeds
+const (
+	FeeRecipientProtocolValue uint8 = 0
+	FeeRecipientCreatorValue  uint8 = 1
+)
+
+// Uint8 converts FeeRecipient to its wire value
+func (r FeeRecipient) Uint8() uint8 {
+	if r == FeeRecipientCreator {
+		return FeeRecipientCreatorValue
+	}
+	return FeeRecipientProtocolValue
+}
+
+// ParseFeeRecipient converts uint8 to FeeRecipient, rejecting unknown values
+func ParseFeeRecipient(recipient uint8) (FeeRecipient, error) {
+	switch recipient {
+	case FeeRecipientProtocolValue:
+		return FeeRecipientProtocol, nil
+	case FeeRecipientCreatorValue:
+		return FeeRecipientCreator, nil
+	default:
+		return "", ErrInvalidFeeRecipient
+	}
+}
+
+// AccruedFees returns the fees collected for recipient and not withdrawn yet
+func (m *Market) AccruedFees(recipient FeeRecipient) uint64 {
+	if recipient == FeeRecipientCreator {
+		return m.CreatorFees
+	}
+	return m.ProtocolFees
+}
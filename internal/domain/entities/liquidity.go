@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// LiquidityPosition represents a provider's share of a market's constant-product pool
+type LiquidityPosition struct {
+	ID            string
+	MarketID      string
+	Provider      string // Public key of the liquidity provider
+	LPShares      uint64
+	YesShares     uint64 // Outcome shares left over from unbalanced adds and removes
+	NoShares      uint64
+	FeeDebt       uint64 // LPShares * Pool.AccFeePerShare at the last settlement
+	ClaimableFees uint64 // Settled fees in collateral base units not yet paid out
+	CreatedAt     time.Time
+	Version       uint64 // Incremented on every update, used for optimistic locking
+}
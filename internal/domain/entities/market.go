@@ -1,21 +1,58 @@
 package entities
 
 import (
+	"errors"
 	"time"
 )
 
+var (
+	ErrInvalidResolution = errors.New("invalid market resolution")
+)
+
 // Market represents a prediction market entity
 type Market struct {
-	ID          string
-	Title       string
-	Description string
-	Category    string
-	EndDate     time.Time
-	Resolution  MarketResolution
-	Status      MarketStatus
-	Creator     string // Public key of the creator
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                 string
+	Title              string
+	Description        string
+	Category           string
+	EndDate            time.Time
+	Resolution         MarketResolution
+	ResolvedOutcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	ResolvedValue      int64 // Numeric outcome when Resolution is ResolutionScalar, before clamping
+	Status             MarketStatus
+	Creator            string            // Public key of the creator
+	Liquidity          uint64            // LMSR liquidity parameter b, in collateral base units
+	YesShares          uint64            // Outstanding YES share units
+	NoShares           uint64            // Outstanding NO share units
+	Outcomes           []string          // Outcome names of a categorical market, empty for YES/NO markets
+	OutcomeShares      []uint64          // Outstanding share units per outcome of a categorical market
+	Scalar             *ScalarRange      // Range of a scalar market, nil otherwise
+	Oracle             *Oracle           // Oracle the market is resolved from, nil if resolved by its creator
+	Optimistic         *OptimisticConfig // Bonded proposal settings, nil if not resolved optimistically
+	Proposal           *Proposal         // Resolution proposed for an optimistic market, nil if none is pending
+	PricingModel       PricingModel
+	Pool               Pool   // Constant-product pool state, unused by LMSR markets
+	Collateral         uint64 // Collateral base units held in the market vault for this market
+	CollateralMint     string // SPL token mint the market settles in, empty for native SOL
+	CollateralDecimals uint8
+	OutcomeTokens      bool   // Positions are also held as transferable outcome SPL tokens
+	OrderSequence      uint64 // Orders placed on the market's order book so far
+	ProtocolFeeBps     uint16 // Protocol fee charged on trades, in basis points
+	CreatorFeeBps      uint16 // Creator fee charged on trades, in basis points
+	ProtocolFees       uint64 // Protocol fees held in the protocol fee vault, not withdrawn yet
+	CreatorFees        uint64 // Creator fees held in the creator fee vault, not withdrawn yet
+	Frozen             bool   // Halts trading, claims and redemptions in this market
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Version            uint64 // Incremented on every update, used for optimistic locking
+}
+
+// NativeCollateralDecimals is the number of decimals of lamports in a SOL
+const NativeCollateralDecimals uint8 = 9
+
+// IsNativeCollateral reports whether the market settles in lamports rather than an SPL token
+func (m *Market) IsNativeCollateral() bool {
+	return m.CollateralMint == ""
 }
 
 // MarketResolution represents how a market is resolved
@@ -26,8 +63,32 @@ const (
 	ResolutionYes       MarketResolution = "yes"
 	ResolutionNo        MarketResolution = "no"
 	ResolutionCancelled MarketResolution = "cancelled"
+	// ResolutionOutcome resolves a categorical market to Market.ResolvedOutcome
+	ResolutionOutcome MarketResolution = "outcome"
+	// ResolutionScalar resolves a scalar market to Market.ResolvedValue
+	ResolutionScalar MarketResolution = "scalar"
+)
+
+// MarketResolution wire values, shared by instructions and accounts
+const (
+	ResolutionPendingValue   uint8 = 0
+	ResolutionYesValue       uint8 = 1
+	ResolutionNoValue        uint8 = 2
+	ResolutionCancelledValue uint8 = 3
+	ResolutionOutcomeValue   uint8 = 4
+	ResolutionScalarValue    uint8 = 5
 )
 
+// IsFinal reports whether the resolution is a valid outcome to resolve a market with
+func (r MarketResolution) IsFinal() bool {
+	switch r {
+	case ResolutionYes, ResolutionNo, ResolutionCancelled, ResolutionOutcome, ResolutionScalar:
+		return true
+	default:
+		return false
+	}
+}
+
 // MarketStatus represents the current status of a market
 type MarketStatus string
 
@@ -36,6 +97,10 @@ const (
 	StatusClosed    MarketStatus = "closed"
 	StatusResolved  MarketStatus = "resolved"
 	StatusCancelled MarketStatus = "cancelled"
+	// StatusProposed is an optimistic market whose proposed resolution is in its dispute window
+	StatusProposed MarketStatus = "proposed"
+	// StatusDisputed is an optimistic market whose proposal awaits the arbiter's decision
+	StatusDisputed MarketStatus = "disputed"
 )
 
 // StatusToUint8 converts MarketStatus to uint8
@@ -49,6 +114,10 @@ func (m *Market) StatusToUint8() uint8 {
 		return 2
 	case StatusCancelled:
 		return 3
+	case StatusProposed:
+		return 4
+	case StatusDisputed:
+		return 5
 	default:
 		return 0
 	}
@@ -65,6 +134,10 @@ func Uint8ToStatus(status uint8) MarketStatus {
 		return StatusResolved
 	case 3:
 		return StatusCancelled
+	case 4:
+		return StatusProposed
+	case 5:
+		return StatusDisputed
 	default:
 		return StatusOpen
 	}
@@ -72,33 +145,54 @@ func Uint8ToStatus(status uint8) MarketStatus {
 
 // ResolutionToUint8 converts MarketResolution to uint8
 func (m *Market) ResolutionToUint8() uint8 {
-	switch m.Resolution {
+	return m.Resolution.Uint8()
+}
+
+// Uint8 converts MarketResolution to its wire value
+func (r MarketResolution) Uint8() uint8 {
+	switch r {
 	case ResolutionPending:
-		return 0
+		return ResolutionPendingValue
 	case ResolutionYes:
-		return 1
+		return ResolutionYesValue
 	case ResolutionNo:
-		return 2
+		return ResolutionNoValue
 	case ResolutionCancelled:
-		return 3
+		return ResolutionCancelledValue
+	case ResolutionOutcome:
+		return ResolutionOutcomeValue
+	case ResolutionScalar:
+		return ResolutionScalarValue
 	default:
-		return 0
+		return ResolutionPendingValue
 	}
 }
 
 // Uint8ToResolution converts uint8 to MarketResolution
 func Uint8ToResolution(resolution uint8) MarketResolution {
-	switch resolution {
-	case 0:
-		return ResolutionPending
-	case 1:
-		return ResolutionYes
-	case 2:
-		return ResolutionNo
-	case 3:
-		return ResolutionCancelled
-	default:
+	r, err := ParseResolution(resolution)
+	if err != nil {
 		return ResolutionPending
 	}
+	return r
 }
 
+// ParseResolution converts uint8 to MarketResolution, rejecting unknown values
+func ParseResolution(resolution uint8) (MarketResolution, error) {
+	switch resolution {
+	case ResolutionPendingValue:
+		return ResolutionPending, nil
+	case ResolutionYesValue:
+		return ResolutionYes, nil
+	case ResolutionNoValue:
+		return ResolutionNo, nil
+	case ResolutionCancelledValue:
+		return ResolutionCancelled, nil
+	case ResolutionOutcomeValue:
+		return ResolutionOutcome, nil
+	case ResolutionScalarValue:
+		return ResolutionScalar, nil
+	default:
+		return "", ErrInvalidResolution
+	}
+}
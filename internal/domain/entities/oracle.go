@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidOracle     = errors.New("invalid market oracle")
+	ErrInvalidOracleKind = errors.New("invalid oracle kind")
+)
+
+// OracleKind selects where an oracle-backed market takes its resolution from
+type OracleKind string
+
+const (
+	// OracleResolver is a designated key that reports the resolution
+	OracleResolver OracleKind = "resolver"
+	// OraclePriceFeed is a Pyth-style price feed whose prices are compared with a threshold
+	OraclePriceFeed OracleKind = "price_feed"
+	// OracleCommittee is a committee account whose members report the resolution together
+	OracleCommittee OracleKind = "committee"
+)
+
+// OracleKind wire values, shared by instructions and accounts.
+// OracleNoneValue marks a market resolved by its creator.
+const (
+	OracleNoneValue      uint8 = 0
+	OracleResolverValue  uint8 = 1
+	OraclePriceFeedValue uint8 = 2
+	OracleCommitteeValue uint8 = 3
+)
+
+// Uint8 converts OracleKind to its wire value
+func (k OracleKind) Uint8() uint8 {
+	switch k {
+	case OracleResolver:
+		return OracleResolverValue
+	case OraclePriceFeed:
+		return OraclePriceFeedValue
+	case OracleCommittee:
+		return OracleCommitteeValue
+	default:
+		return OracleNoneValue
+	}
+}
+
+// ParseOracleKind converts uint8 to OracleKind, rejecting unknown values and OracleNoneValue
+func ParseOracleKind(kind uint8) (OracleKind, error) {
+	switch kind {
+	case OracleResolverValue:
+		return OracleResolver, nil
+	case OraclePriceFeedValue:
+		return OraclePriceFeed, nil
+	case OracleCommitteeValue:
+		return OracleCommittee, nil
+	default:
+		return "", ErrInvalidOracleKind
+	}
+}
+
+// Oracle is the source an oracle-backed market is resolved from instead of its creator
+type Oracle struct {
+	Kind    OracleKind
+	Account string // Resolver key, price feed ID or committee account
+	// Threshold is the price at or above which a YES/NO market resolves YES,
+	// in units of 10^Exponent; only used by price feeds
+	Threshold int64
+	// Exponent is the decimal exponent prices are compared and scalar markets
+	// are resolved in; feed prices are rescaled to it
+	Exponent int32
+}
+
+// IsOracleBacked reports whether the market is resolved from an oracle rather than by its creator
+func (m *Market) IsOracleBacked() bool {
+	return m.Oracle != nil
+}
+
+// PriceFeed is a price published by a Pyth-style price feed, as posted in a
+// price update account
+type PriceFeed struct {
+	FeedID          string // Price feed ID, in the same encoding as Oracle.Account
+	Price           int64  // Price in units of 10^Exponent
+	Confidence      uint64 // Confidence interval around Price, in the same units
+	Exponent        int32
+	PublishTime     time.Time
+	PrevPublishTime time.Time // Publish time of the feed's previous price
+	Verified        bool      // The update carries every guardian signature the oracle requires
+}
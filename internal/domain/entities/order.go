@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidOrderSide = errors.New("invalid order side")
+)
+
+// Order is a limit order resting on a market's order book for one outcome
+type Order struct {
+	ID        string
+	MarketID  string
+	UserID    string // Public key of the order owner
+	Outcome   uint8
+	Side      OrderSide
+	Price     uint64 // Limit price per full share in collateral base units
+	Size      uint64 // Share units to trade
+	Filled    uint64 // Share units traded so far
+	Escrow    uint64 // Collateral still held in the market vault for a buy order
+	Status    OrderStatus
+	Sequence  uint64 // Placement order within the market, used for time priority
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version   uint64 // Incremented on every update, used for optimistic locking
+}
+
+// Remaining returns the share units not filled yet
+func (o *Order) Remaining() uint64 {
+	return o.Size - o.Filled
+}
+
+// IsOpen reports whether the order can still be matched or cancelled
+func (o *Order) IsOpen() bool {
+	return o.Status == OrderStatusOpen
+}
+
+// Fill is a trade between a resting maker order and an incoming taker order.
+// It executes at the maker's price.
+type Fill struct {
+	MarketID     string
+	Outcome      uint8
+	MakerOrderID string
+	TakerOrderID string
+	Buyer        string
+	Seller       string
+	Price        uint64 // Execution price per full share
+	Shares       uint64 // Share units traded
+	Cost         uint64 // Collateral paid by the buyer, paid to the seller less fees
+}
+
+// OrderSide represents whether an order buys or sells shares
+type OrderSide string
+
+const (
+	OrderBuy  OrderSide = "buy"
+	OrderSell OrderSide = "sell"
+)
+
+// OrderSide wire values, shared by instructions and accounts
+const (
+	OrderBuyValue  uint8 = 0
+	OrderSellValue uint8 = 1
+)
+
+// Opposite returns the side an order matches against
+func (s OrderSide) Opposite() OrderSide {
+	if s == OrderBuy {
+		return OrderSell
+	}
+	return OrderBuy
+}
+
+// Uint8 converts OrderSide to its wire value
+func (s OrderSide) Uint8() uint8 {
+	if s == OrderSell {
+		return OrderSellValue
+	}
+	return OrderBuyValue
+}
+
+// ParseOrderSide converts uint8 to OrderSide, rejecting unknown values
+func ParseOrderSide(side uint8) (OrderSide, error) {
+	switch side {
+	case OrderBuyValue:
+		return OrderBuy, nil
+	case OrderSellValue:
+		return OrderSell, nil
+	default:
+		return "", ErrInvalidOrderSide
+	}
+}
+
+// OrderStatus represents the lifecycle of an order
+type OrderStatus string
+
+const (
+	OrderStatusOpen      OrderStatus = "open"
+	OrderStatusFilled    OrderStatus = "filled"
+	OrderStatusCancelled OrderStatus = "cancelled"
+)
+
+// OrderStatus wire values, shared by accounts
+const (
+	OrderStatusOpenValue      uint8 = 0
+	OrderStatusFilledValue    uint8 = 1
+	OrderStatusCancelledValue uint8 = 2
+)
+
+// Uint8 converts OrderStatus to its wire value
+func (s OrderStatus) Uint8() uint8 {
+	switch s {
+	case OrderStatusFilled:
+		return OrderStatusFilledValue
+	case OrderStatusCancelled:
+		return OrderStatusCancelledValue
+	default:
+		return OrderStatusOpenValue
+	}
+}
+
+// Uint8ToOrderStatus converts uint8 to OrderStatus
+func Uint8ToOrderStatus(status uint8) OrderStatus {
+	switch status {
+	case OrderStatusFilledValue:
+		return OrderStatusFilled
+	case OrderStatusCancelledValue:
+		return OrderStatusCancelled
+	default:
+		return OrderStatusOpen
+	}
+}
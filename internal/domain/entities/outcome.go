@@ -0,0 +1,133 @@
+package entities
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidOutcome  = errors.New("invalid outcome")
+	ErrInvalidOutcomes = errors.New("categorical markets need between 2 and 16 distinct named outcomes")
+)
+
+// Outcome limits of categorical markets, bounding the market account size
+const (
+	MinOutcomes          = 2
+	MaxOutcomes          = 16
+	MaxOutcomeNameLength = 50
+)
+
+// BinaryOutcomes is the number of outcomes of a YES/NO market, indexed by PositionSide wire values
+const BinaryOutcomes = 2
+
+// IsCategorical reports whether the market has named outcomes rather than YES/NO
+func (m *Market) IsCategorical() bool {
+	return len(m.Outcomes) > 0
+}
+
+// OutcomeCount returns the number of outcomes of the market
+func (m *Market) OutcomeCount() int {
+	if m.IsCategorical() {
+		return len(m.Outcomes)
+	}
+	return BinaryOutcomes
+}
+
+// ValidateOutcome rejects an outcome index the market does not have
+func (m *Market) ValidateOutcome(outcome uint8) error {
+	if int(outcome) >= m.OutcomeCount() {
+		return ErrInvalidOutcome
+	}
+	return nil
+}
+
+// OutstandingShares returns the outstanding share units of an outcome
+func (m *Market) OutstandingShares(outcome uint8) uint64 {
+	if m.IsCategorical() {
+		if int(outcome) < len(m.OutcomeShares) {
+			return m.OutcomeShares[outcome]
+		}
+		return 0
+	}
+	if outcome == SideYesValue {
+		return m.YesShares
+	}
+	return m.NoShares
+}
+
+// SetOutstandingShares sets the outstanding share units of an outcome
+func (m *Market) SetOutstandingShares(outcome uint8, shares uint64) {
+	if m.IsCategorical() {
+		for len(m.OutcomeShares) < len(m.Outcomes) {
+			m.OutcomeShares = append(m.OutcomeShares, 0)
+		}
+		m.OutcomeShares[outcome] = shares
+		return
+	}
+	if outcome == SideYesValue {
+		m.YesShares = shares
+	} else {
+		m.NoShares = shares
+	}
+}
+
+// WinningOutcome returns the winning outcome index of a resolved market.
+// ok is false while pending, for cancelled markets and for scalar markets,
+// which pay both outcomes in proportion to the resolved value.
+func (m *Market) WinningOutcome() (outcome uint8, ok bool) {
+	switch m.Resolution {
+	case ResolutionYes:
+		return SideYesValue, !m.IsCategorical() && !m.IsScalar()
+	case ResolutionNo:
+		return SideNoValue, !m.IsCategorical() && !m.IsScalar()
+	case ResolutionOutcome:
+		return m.ResolvedOutcome, m.IsCategorical()
+	default:
+		return 0, false
+	}
+}
+
+// ValidateResolution checks that a final resolution fits the market's outcomes.
+// Categorical markets resolve to one of their outcomes or are cancelled;
+// YES/NO markets never use ResolutionOutcome. Scalar markets resolve to a
+// value through ResolveScalar, so they can only be cancelled here.
+func (m *Market) ValidateResolution(resolution MarketResolution, outcome uint8) error {
+	if !resolution.IsFinal() || resolution == ResolutionScalar {
+		return ErrInvalidResolution
+	}
+	if m.IsScalar() {
+		if resolution != ResolutionCancelled {
+			return ErrInvalidResolution
+		}
+		return nil
+	}
+	if !m.IsCategorical() {
+		if resolution == ResolutionOutcome {
+			return ErrInvalidResolution
+		}
+		return nil
+	}
+	switch resolution {
+	case ResolutionOutcome:
+		return m.ValidateOutcome(outcome)
+	case ResolutionCancelled:
+		return nil
+	default:
+		return ErrInvalidResolution
+	}
+}
+
+// ValidateOutcomes checks the outcome names of a categorical market
+func ValidateOutcomes(outcomes []string) error {
+	if len(outcomes) < MinOutcomes || len(outcomes) > MaxOutcomes {
+		return ErrInvalidOutcomes
+	}
+
+	seen := make(map[string]bool, len(outcomes))
+	for _, name := range outcomes {
+		if name == "" || len(name) > MaxOutcomeNameLength || seen[name] {
+			return ErrInvalidOutcomes
+		}
+		seen[name] = true
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidPricingModel = errors.New("invalid pricing model")
+)
+
+// PricingModel selects the automated market maker used by a market
+type PricingModel string
+
+const (
+	PricingLMSR            PricingModel = "lmsr"
+	PricingConstantProduct PricingModel = "constant_product"
+)
+
+// PricingModel wire values, shared by instructions and accounts
+const (
+	PricingLMSRValue            uint8 = 0
+	PricingConstantProductValue uint8 = 1
+)
+
+// Pool is the state of a constant-product (FPMM) market maker.
+// Every unit of collateral added mints one YES and one NO share; the pool
+// keeps YesReserve * NoReserve constant across trades, excluding fees.
+type Pool struct {
+	YesReserve     uint64 // YES share units held by the pool
+	NoReserve      uint64 // NO share units held by the pool
+	LPSupply       uint64 // Outstanding liquidity provider shares
+	FeeBps         uint16 // Trading fee paid to liquidity providers
+	AccFeePerShare uint64 // Accumulated fees per LP share, scaled by FeePrecision
+	Fees           uint64 // Fees in collateral base units not yet paid out to liquidity providers
+}
+
+// FeePrecision scales Pool.AccFeePerShare
+const FeePrecision uint64 = 1_000_000_000_000
+
+// PricingModelToUint8 converts PricingModel to uint8
+func (m *Market) PricingModelToUint8() uint8 {
+	if m.PricingModel == PricingConstantProduct {
+		return PricingConstantProductValue
+	}
+	return PricingLMSRValue
+}
+
+// Uint8ToPricingModel converts uint8 to PricingModel
+func Uint8ToPricingModel(model uint8) PricingModel {
+	if model == PricingConstantProductValue {
+		return PricingConstantProduct
+	}
+	return PricingLMSR
+}
+
+// ParsePricingModel converts uint8 to PricingModel, rejecting unknown values
+func ParsePricingModel(model uint8) (PricingModel, error) {
+	switch model {
+	case PricingLMSRValue:
+		return PricingLMSR, nil
+	case PricingConstantProductValue:
+		return PricingConstantProduct, nil
+	default:
+		return "", ErrInvalidPricingModel
+	}
+}
@@ -1,16 +1,34 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidSide = errors.New("invalid position side")
+)
 
 // Position represents a user's position in a market
 type Position struct {
-	ID        string
-	MarketID  string
-	UserID    string // Public key of the user
-	Side      PositionSide
-	Amount    uint64 // Amount in lamports
-	Price     uint64 // Price per share in lamports
-	CreatedAt time.Time
+	ID          string
+	MarketID    string
+	UserID      string // Public key of the user
+	Outcome     uint8  // Outcome index; for YES/NO markets the PositionSide wire value
+	Amount      uint64 // Collateral paid including fees, in base units of the market's collateral
+	CostBasis   uint64 // Collateral escrowed in the market vault for the shares, excluding fees
+	Shares      uint64 // Share units, each paying 1 collateral base unit if the outcome wins
+	Price       uint64 // Price per full share in collateral base units
+	Locked      uint64 // Share units reserved by open sell orders
+	RealizedPnL int64  // Collateral received for sold shares minus their cost basis
+	Claimed     bool   // Set once the payout has been paid after resolution
+	CreatedAt   time.Time
+	Version     uint64 // Incremented on every update, used for optimistic locking
+}
+
+// AvailableShares returns the share units not reserved by open sell orders
+func (p *Position) AvailableShares() uint64 {
+	return p.Shares - p.Locked
 }
 
 // PositionSide represents whether the position is YES or NO
@@ -21,3 +39,41 @@ const (
 	SideNo  PositionSide = "no"
 )
 
+// PositionSide wire values, shared by instructions and accounts
+const (
+	SideNoValue  uint8 = 0
+	SideYesValue uint8 = 1
+)
+
+// IsValid reports whether the side is YES or NO
+func (s PositionSide) IsValid() bool {
+	return s == SideYes || s == SideNo
+}
+
+// Uint8 converts PositionSide to its wire value
+func (s PositionSide) Uint8() uint8 {
+	if s == SideYes {
+		return SideYesValue
+	}
+	return SideNoValue
+}
+
+// Uint8ToSide converts uint8 to PositionSide
+func Uint8ToSide(side uint8) PositionSide {
+	if side == SideYesValue {
+		return SideYes
+	}
+	return SideNo
+}
+
+// ParseSide converts uint8 to PositionSide, rejecting unknown values
+func ParseSide(side uint8) (PositionSide, error) {
+	switch side {
+	case SideNoValue:
+		return SideNo, nil
+	case SideYesValue:
+		return SideYes, nil
+	default:
+		return "", ErrInvalidSide
+	}
+}
@@ -0,0 +1,140 @@
+package entities
+
+import "errors"
+
+var (
+	ErrInvalidRole        = errors.New("invalid role")
+	ErrRoleNotGranted     = errors.New("role is not granted to the key")
+	ErrTooManyRoleHolders = errors.New("role has too many holders")
+	ErrNoPendingAdmin     = errors.New("no admin transfer is pending for the key")
+)
+
+// MaxRoleHolders is the maximum number of keys holding the resolver or pauser role
+const MaxRoleHolders = 16
+
+// Role is a permission granted to keys in the program config
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleResolver Role = "resolver"
+	RolePauser   Role = "pauser"
+)
+
+// Role wire values, shared by instructions
+const (
+	RoleAdminValue    uint8 = 0
+	RoleResolverValue uint8 = 1
+	RolePauserValue   uint8 = 2
+)
+
+// Uint8 converts Role to its wire value
+func (r Role) Uint8() uint8 {
+	switch r {
+	case RoleResolver:
+		return RoleResolverValue
+	case RolePauser:
+		return RolePauserValue
+	default:
+		return RoleAdminValue
+	}
+}
+
+// ParseRole converts uint8 to Role, rejecting unknown values
+func ParseRole(role uint8) (Role, error) {
+	switch role {
+	case RoleAdminValue:
+		return RoleAdmin, nil
+	case RoleResolverValue:
+		return RoleResolver, nil
+	case RolePauserValue:
+		return RolePauser, nil
+	default:
+		return "", ErrInvalidRole
+	}
+}
+
+// ProgramConfig is the program-wide configuration holding the keys granted
+// each role. There is a single admin, changed with a two-step transfer; the
+// resolver and pauser roles can each be held by several keys.
+type ProgramConfig struct {
+	Admin          string   // Public key of the admin, who implicitly holds every role
+	PendingAdmin   string   // Public key the admin role is being transferred to; empty if none
+	Resolvers      []string // Public keys allowed to resolve and close any creator-resolved market
+	Pausers        []string // Public keys allowed to pause the protocol and freeze markets
+	Paused         bool     // Halts trading, claims and redemptions in every market
+	ProtocolFeeBps uint16   // Protocol fee in basis points given to markets when they are created
+	Version        uint64   // Incremented on every update, used for optimistic locking
+}
+
+// HasRole reports whether key holds role. The admin holds every role.
+func (c *ProgramConfig) HasRole(key string, role Role) bool {
+	if key == "" {
+		return false
+	}
+	if key == c.Admin {
+		return true
+	}
+	switch role {
+	case RoleResolver:
+		return containsString(c.Resolvers, key)
+	case RolePauser:
+		return containsString(c.Pausers, key)
+	default:
+		return false
+	}
+}
+
+// Grant grants role to key. Granting a role the key already holds is a no-op.
+// The admin role is only changed through an admin transfer.
+func (c *ProgramConfig) Grant(role Role, key string) error {
+	holders, err := c.holders(role)
+	if err != nil {
+		return err
+	}
+	if containsString(*holders, key) {
+		return nil
+	}
+	if len(*holders) >= MaxRoleHolders {
+		return ErrTooManyRoleHolders
+	}
+	*holders = append(*holders, key)
+	return nil
+}
+
+// Revoke revokes role from key
+func (c *ProgramConfig) Revoke(role Role, key string) error {
+	holders, err := c.holders(role)
+	if err != nil {
+		return err
+	}
+	for i, holder := range *holders {
+		if holder == key {
+			*holders = append((*holders)[:i], (*holders)[i+1:]...)
+			return nil
+		}
+	}
+	return ErrRoleNotGranted
+}
+
+// holders returns the list of keys holding a grantable role
+func (c *ProgramConfig) holders(role Role) (*[]string, error) {
+	switch role {
+	case RoleResolver:
+		return &c.Resolvers, nil
+	case RolePauser:
+		return &c.Pausers, nil
+	default:
+		return nil, ErrInvalidRole
+	}
+}
+
+// containsString reports whether s is one of list
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
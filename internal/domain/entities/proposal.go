@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidOptimisticConfig = errors.New("optimistic resolution needs a bond, a dispute window and an arbiter")
+)
+
+// OptimisticConfig enables optimistic resolution: anyone proposes a resolution
+// with a bond, which becomes final unless it is disputed within the dispute window.
+// Disputes are decided by the arbiter.
+type OptimisticConfig struct {
+	Bond          uint64 // Collateral base units posted by the proposer, and matched by a disputer
+	DisputeWindow time.Duration
+	Arbiter       string // Public key deciding disputed proposals
+}
+
+// Validate rejects configurations under which proposals could not be bonded, disputed or decided
+func (c *OptimisticConfig) Validate() error {
+	if c.Bond == 0 || c.DisputeWindow <= 0 || c.Arbiter == "" {
+		return ErrInvalidOptimisticConfig
+	}
+	return nil
+}
+
+// Proposal is a resolution proposed for an optimistic market, pending its dispute window
+type Proposal struct {
+	Proposer   string
+	Resolution MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+	ProposedAt time.Time
+	Disputer   string // Empty until the proposal is disputed
+	DisputedAt time.Time
+}
+
+// IsOptimistic reports whether the market is resolved through bonded proposals
+func (m *Market) IsOptimistic() bool {
+	return m.Optimistic != nil
+}
+
+// IsDisputed reports whether the proposal has been disputed
+func (p *Proposal) IsDisputed() bool {
+	return p.Disputer != ""
+}
+
+// Matches reports whether the proposal reports the given resolution
+func (p *Proposal) Matches(resolution MarketResolution, outcome uint8, value int64) bool {
+	return sameResolution(p.Resolution, p.Outcome, p.Value, resolution, outcome, value)
+}
+
+// DisputeDeadline returns when the proposal's dispute window ends
+func (m *Market) DisputeDeadline() time.Time {
+	if m.Proposal == nil || m.Optimistic == nil {
+		return time.Time{}
+	}
+	return m.Proposal.ProposedAt.Add(m.Optimistic.DisputeWindow)
+}
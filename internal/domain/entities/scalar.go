@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidScalarRange = errors.New("scalar range minimum must be below its maximum")
+	ErrNotScalarMarket    = errors.New("market is not a scalar market")
+)
+
+// Scalar market outcomes. LONG gains as the resolved value approaches the range
+// maximum and SHORT as it approaches the minimum; they reuse the YES/NO share slots.
+const (
+	OutcomeShort = SideNoValue
+	OutcomeLong  = SideYesValue
+)
+
+// ScalarRange is the numeric range a scalar market resolves within
+type ScalarRange struct {
+	Min int64
+	Max int64
+}
+
+// Validate rejects empty or inverted ranges
+func (r ScalarRange) Validate() error {
+	if r.Min >= r.Max {
+		return ErrInvalidScalarRange
+	}
+	return nil
+}
+
+// Clamp limits value to the range
+func (r ScalarRange) Clamp(value int64) int64 {
+	if value < r.Min {
+		return r.Min
+	}
+	if value > r.Max {
+		return r.Max
+	}
+	return value
+}
+
+// Width returns Max - Min, which may exceed math.MaxInt64
+func (r ScalarRange) Width() uint64 {
+	return uint64(r.Max) - uint64(r.Min)
+}
+
+// Offset returns how far the clamped value lies above Min
+func (r ScalarRange) Offset(value int64) uint64 {
+	return uint64(r.Clamp(value)) - uint64(r.Min)
+}
+
+// IsScalar reports whether the market pays out linearly on a resolved numeric value
+func (m *Market) IsScalar() bool {
+	return m.Scalar != nil
+}
@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+// Vote is a committee member's vote on the resolution of a market.
+// A member has one vote per market and can change it until the market resolves.
+type Vote struct {
+	ID         string
+	MarketID   string
+	Voter      string // Public key of the committee member
+	Resolution MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+	VotedAt    time.Time
+	Version    uint64 // Incremented on every update, used for optimistic locking
+}
+
+// Matches reports whether the vote is for the given resolution
+func (v *Vote) Matches(resolution MarketResolution, outcome uint8, value int64) bool {
+	return sameResolution(v.Resolution, v.Outcome, v.Value, resolution, outcome, value)
+}
+
+// VoteTally counts the votes cast for one resolution of a market
+type VoteTally struct {
+	Resolution MarketResolution
+	Outcome    uint8
+	Value      int64
+	Votes      uint8
+}
+
+// sameResolution reports whether two reported resolutions agree. Outcomes
+// only matter for categorical resolutions and values for scalar ones.
+func sameResolution(a MarketResolution, aOutcome uint8, aValue int64, b MarketResolution, bOutcome uint8, bValue int64) bool {
+	if a != b {
+		return false
+	}
+	switch a {
+	case ResolutionOutcome:
+		return aOutcome == bOutcome
+	case ResolutionScalar:
+		return aValue == bValue
+	default:
+		return true
+	}
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// CommitteeRepository defines the interface for resolver committee data operations
+type CommitteeRepository interface {
+	Create(ctx context.Context, committee *entities.Committee) error
+	GetByAddress(ctx context.Context, address string) (*entities.Committee, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// ConfigRepository defines the interface for program config data operations
+type ConfigRepository interface {
+	Create(ctx context.Context, config *entities.ProgramConfig) error
+	Get(ctx context.Context) (*entities.ProgramConfig, error)
+	Update(ctx context.Context, config *entities.ProgramConfig) error
+}
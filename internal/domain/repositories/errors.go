@@ -0,0 +1,14 @@
+package repositories
+
+import "errors"
+
+var (
+	ErrPositionNotFound  = errors.New("position not found")
+	ErrLiquidityNotFound = errors.New("liquidity position not found")
+	ErrOrderNotFound     = errors.New("order not found")
+	ErrVoteNotFound      = errors.New("vote not found")
+	ErrCommitteeNotFound = errors.New("committee not found")
+	ErrConfigNotFound    = errors.New("program config not initialized")
+	ErrAlreadyExists     = errors.New("entity already exists")
+	ErrVersionConflict   = errors.New("entity was modified concurrently")
+)
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// LiquidityRepository defines the interface for liquidity position data operations
+type LiquidityRepository interface {
+	Create(ctx context.Context, liquidity *entities.LiquidityPosition) error
+	GetByMarketAndProvider(ctx context.Context, marketID, provider string) (*entities.LiquidityPosition, error)
+	GetByMarketID(ctx context.Context, marketID string) ([]*entities.LiquidityPosition, error)
+	Update(ctx context.Context, liquidity *entities.LiquidityPosition) error
+}
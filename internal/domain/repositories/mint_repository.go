@@ -0,0 +1,10 @@
+package repositories
+
+import (
+	"context"
+)
+
+// MintRepository defines the interface for reading SPL token mints
+type MintRepository interface {
+	GetDecimals(ctx context.Context, mint string) (uint8, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// OracleRepository defines the interface for reading the oracle accounts markets resolve from
+type OracleRepository interface {
+	GetPriceFeed(ctx context.Context, account string) (*entities.PriceFeed, error)
+	GetCommittee(ctx context.Context, account string) (*entities.Committee, error)
+}
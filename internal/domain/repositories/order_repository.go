@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// OrderRepository defines the interface for order book data operations
+type OrderRepository interface {
+	Create(ctx context.Context, order *entities.Order) error
+	GetByID(ctx context.Context, id string) (*entities.Order, error)
+	GetOpenByMarket(ctx context.Context, marketID string, outcome uint8) ([]*entities.Order, error)
+	Update(ctx context.Context, order *entities.Order) error
+}
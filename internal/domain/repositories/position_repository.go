@@ -9,6 +9,7 @@ import (
 type PositionRepository interface {
 	Create(ctx context.Context, position *entities.Position) error
 	GetByID(ctx context.Context, id string) (*entities.Position, error)
+	GetByMarketAndUser(ctx context.Context, marketID, userID string) (*entities.Position, error)
 	GetByMarketID(ctx context.Context, marketID string) ([]*entities.Position, error)
 	GetByUserID(ctx context.Context, userID string) ([]*entities.Position, error)
 	Update(ctx context.Context, position *entities.Position) error
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// VoteRepository defines the interface for committee vote data operations
+type VoteRepository interface {
+	Create(ctx context.Context, vote *entities.Vote) error
+	GetByMarketAndVoter(ctx context.Context, marketID, voter string) (*entities.Vote, error)
+	GetByMarketID(ctx context.Context, marketID string) ([]*entities.Vote, error)
+	Update(ctx context.Context, vote *entities.Vote) error
+}
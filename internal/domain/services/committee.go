@@ -0,0 +1,54 @@
+package services
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrNotCommitteeMember = errors.New("voter is not a member of the market's committee")
+	ErrNotCommitteeMarket = errors.New("market is not resolved by a committee")
+)
+
+// TallyVotes counts the votes of a market per resolution, ignoring votes of
+// keys that are no longer committee members. Tallies are ordered by votes,
+// ties keeping the order in which resolutions were first voted for.
+func TallyVotes(committee *entities.Committee, votes []*entities.Vote) []entities.VoteTally {
+	tallies := make([]entities.VoteTally, 0)
+	for _, vote := range votes {
+		if !committee.IsMember(vote.Voter) {
+			continue
+		}
+		found := false
+		for i := range tallies {
+			if vote.Matches(tallies[i].Resolution, tallies[i].Outcome, tallies[i].Value) {
+				tallies[i].Votes++
+				found = true
+				break
+			}
+		}
+		if !found {
+			tallies = append(tallies, entities.VoteTally{
+				Resolution: vote.Resolution,
+				Outcome:    vote.Outcome,
+				Value:      vote.Value,
+				Votes:      1,
+			})
+		}
+	}
+
+	sort.SliceStable(tallies, func(i, j int) bool {
+		return tallies[i].Votes > tallies[j].Votes
+	})
+	return tallies
+}
+
+// QuorumTally returns the tally that reached the committee's threshold, or nil
+func QuorumTally(committee *entities.Committee, tallies []entities.VoteTally) *entities.VoteTally {
+	if len(tallies) == 0 || tallies[0].Votes < committee.Threshold {
+		return nil
+	}
+	return &tallies[0]
+}
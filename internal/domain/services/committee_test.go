@@ -0,0 +1,72 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func TestTallyVotes(t *testing.T) {
+	committee := &entities.Committee{Members: []string{"a", "b", "c", "d"}, Threshold: 2}
+	vote := func(voter string, resolution entities.MarketResolution, outcome uint8) *entities.Vote {
+		return &entities.Vote{Voter: voter, Resolution: resolution, Outcome: outcome}
+	}
+
+	tests := []struct {
+		name       string
+		votes      []*entities.Vote
+		want       []entities.VoteTally
+		wantQuorum *entities.VoteTally
+	}{
+		{
+			name:  "no votes",
+			votes: nil,
+			want:  []entities.VoteTally{},
+		},
+		{
+			name: "quorum",
+			votes: []*entities.Vote{
+				vote("a", entities.ResolutionNo, 0),
+				vote("b", entities.ResolutionYes, 0),
+				vote("c", entities.ResolutionYes, 0),
+			},
+			want: []entities.VoteTally{
+				{Resolution: entities.ResolutionYes, Votes: 2},
+				{Resolution: entities.ResolutionNo, Votes: 1},
+			},
+			wantQuorum: &entities.VoteTally{Resolution: entities.ResolutionYes, Votes: 2},
+		},
+		{
+			name: "outcomes are tallied apart",
+			votes: []*entities.Vote{
+				vote("a", entities.ResolutionOutcome, 1),
+				vote("b", entities.ResolutionOutcome, 2),
+			},
+			want: []entities.VoteTally{
+				{Resolution: entities.ResolutionOutcome, Outcome: 1, Votes: 1},
+				{Resolution: entities.ResolutionOutcome, Outcome: 2, Votes: 1},
+			},
+		},
+		{
+			name: "former members are ignored",
+			votes: []*entities.Vote{
+				vote("a", entities.ResolutionYes, 0),
+				vote("x", entities.ResolutionYes, 0),
+			},
+			want: []entities.VoteTally{{Resolution: entities.ResolutionYes, Votes: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TallyVotes(committee, tt.votes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("TallyVotes() = %+v, want %+v", got, tt.want)
+			}
+			quorum := QuorumTally(committee, got)
+			if !reflect.DeepEqual(quorum, tt.wantQuorum) {
+				t.Fatalf("QuorumTally() = %+v, want %+v", quorum, tt.wantQuorum)
+			}
+		})
+	}
+}
@@ -0,0 +1,414 @@
+package services
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// MaxPoolFeeBps caps the liquidity provider fee of a constant-product pool
+const MaxPoolFeeBps uint16 = 1_000
+
+// LiquidityChange is the result of adding or removing pool liquidity
+type LiquidityChange struct {
+	Collateral uint64 // Collateral base units deposited on add, returned on remove
+	LPShares   uint64 // LP shares minted on add, burned on remove
+	YesShares  uint64 // YES shares left to the provider
+	NoShares   uint64 // NO shares left to the provider
+}
+
+// LiquidityPool manages liquidity for pool-based market makers
+type LiquidityPool interface {
+	// AddLiquidity deposits collateral into the market's pool and mints LP shares
+	AddLiquidity(market *entities.Market, amount uint64) (*LiquidityChange, error)
+	// RemoveLiquidity burns LP shares and withdraws the provider's part of the pool
+	RemoveLiquidity(market *entities.Market, lpShares uint64) (*LiquidityChange, error)
+}
+
+// ConstantProduct implements a fixed-product market maker (FPMM) for binary markets.
+//
+// Buying N shares of an outcome with net collateral x mints x YES and x NO into
+// the pool and takes N shares of the bought outcome out, keeping
+// YesReserve * NoReserve constant. Selling is the reverse. A fee of
+// Pool.FeeBps is charged on every trade and accrues to liquidity providers.
+type ConstantProduct struct{}
+
+// NewConstantProduct creates a new ConstantProduct pricing engine
+func NewConstantProduct() *ConstantProduct {
+	return &ConstantProduct{}
+}
+
+var (
+	_ PricingEngine = (*ConstantProduct)(nil)
+	_ LiquidityPool = (*ConstantProduct)(nil)
+)
+
+// Price returns the current marginal price of outcome in collateral base units per full share
+func (c *ConstantProduct) Price(market *entities.Market, outcome uint8) (uint64, error) {
+	if err := validatePoolOutcome(market, outcome); err != nil {
+		return 0, err
+	}
+
+	own, other := poolReserves(&market.Pool, outcome)
+	if own == 0 || other == 0 {
+		return 0, ErrInsufficientLiquidity
+	}
+
+	// The price of an outcome is the share of the pool held in the opposite outcome
+	total := new(big.Int).Add(u64(own), u64(other))
+	price := new(big.Int).Mul(u64(other), u64(PriceScale))
+	price.Quo(price, total)
+	return price.Uint64(), nil
+}
+
+// QuoteBuy returns the cost of buying shares of outcome without changing the market
+func (c *ConstantProduct) QuoteBuy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	if err := validatePoolOutcome(market, outcome); err != nil {
+		return nil, err
+	}
+	if shares == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	own, other := poolReserves(&market.Pool, outcome)
+	if own == 0 || other == 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	// Solve (own + x - shares) * (other + x) = own * other for the net collateral x:
+	// x^2 + x*(own + other - shares) - other*shares = 0
+	b := new(big.Int).Add(u64(own), u64(other))
+	b.Sub(b, u64(shares))
+	disc := new(big.Int).Mul(b, b)
+	disc.Add(disc, new(big.Int).Mul(big.NewInt(4), new(big.Int).Mul(u64(other), u64(shares))))
+
+	// Round x up so the invariant never decreases
+	x := new(big.Int).Sub(sqrtCeil(disc), b)
+	x = divCeil(x, big.NewInt(2))
+	if !x.IsUint64() {
+		return nil, ErrArithmeticOverflow
+	}
+	net := x.Uint64()
+
+	// Gross up for the fee, charged on the collateral paid in
+	fee := market.Pool.FeeBps
+	gross, err := MulDivCeil(net, BasisPoints, BasisPoints-uint64(fee))
+	if err != nil {
+		return nil, err
+	}
+
+	averagePrice, err := AveragePrice(gross, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quote{
+		Outcome:      outcome,
+		Shares:       shares,
+		Cost:         gross,
+		Fee:          gross - net,
+		AveragePrice: averagePrice,
+	}, nil
+}
+
+// Buy prices the trade and updates the pool reserves
+func (c *ConstantProduct) Buy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	quote, err := c.QuoteBuy(market, outcome, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	net := quote.Cost - quote.Fee
+	own, other := poolReserves(&market.Pool, outcome)
+	ownAfter, err := addU64(own, net)
+	if err != nil {
+		return nil, err
+	}
+	otherAfter, err := addU64(other, net)
+	if err != nil {
+		return nil, err
+	}
+	setPoolReserves(&market.Pool, outcome, ownAfter-shares, otherAfter)
+	if err := accrueFee(&market.Pool, quote.Fee); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// QuoteSell returns the proceeds of selling shares of outcome without changing the market
+func (c *ConstantProduct) QuoteSell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	if err := validatePoolOutcome(market, outcome); err != nil {
+		return nil, err
+	}
+	if shares == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	own, other := poolReserves(&market.Pool, outcome)
+	if own == 0 || other == 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	// Solve (own + shares - r) * (other - r) = own * other for the merged collateral r:
+	// r^2 - r*(own + shares + other) + shares*other = 0, taking the smaller root
+	b := new(big.Int).Add(u64(own), u64(other))
+	b.Add(b, u64(shares))
+	disc := new(big.Int).Mul(b, b)
+	disc.Sub(disc, new(big.Int).Mul(big.NewInt(4), new(big.Int).Mul(u64(shares), u64(other))))
+
+	// Round r down so the invariant never decreases
+	r := new(big.Int).Sub(b, sqrtCeil(disc))
+	r.Quo(r, big.NewInt(2))
+	if r.Sign() < 0 || !r.IsUint64() {
+		return nil, ErrArithmeticOverflow
+	}
+	gross := r.Uint64()
+
+	fee, err := MulDivCeil(gross, uint64(market.Pool.FeeBps), BasisPoints)
+	if err != nil {
+		return nil, err
+	}
+	proceeds := gross - fee
+
+	averagePrice, err := MulDiv(proceeds, PriceScale, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quote{
+		Outcome:      outcome,
+		Shares:       shares,
+		Cost:         proceeds,
+		Fee:          fee,
+		AveragePrice: averagePrice,
+	}, nil
+}
+
+// Sell prices the trade and updates the pool reserves
+func (c *ConstantProduct) Sell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	quote, err := c.QuoteSell(market, outcome, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	gross := quote.Cost + quote.Fee
+	own, other := poolReserves(&market.Pool, outcome)
+	ownAfter, err := addU64(own, shares)
+	if err != nil {
+		return nil, err
+	}
+	setPoolReserves(&market.Pool, outcome, ownAfter-gross, other-gross)
+	if err := accrueFee(&market.Pool, quote.Fee); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// AddLiquidity deposits collateral into the pool and mints LP shares.
+// Collateral is added in proportion to the current reserves; the outcome
+// shares the pool does not keep are left to the provider.
+func (c *ConstantProduct) AddLiquidity(market *entities.Market, amount uint64) (*LiquidityChange, error) {
+	if amount == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	pool := &market.Pool
+	if pool.LPSupply == 0 {
+		if pool.YesReserve != 0 || pool.NoReserve != 0 {
+			return nil, ErrInsufficientLiquidity
+		}
+		pool.YesReserve = amount
+		pool.NoReserve = amount
+		pool.LPSupply = amount
+		return &LiquidityChange{Collateral: amount, LPShares: amount}, nil
+	}
+
+	weight := pool.YesReserve
+	if pool.NoReserve > weight {
+		weight = pool.NoReserve
+	}
+
+	yesAdded, err := MulDiv(amount, pool.YesReserve, weight)
+	if err != nil {
+		return nil, err
+	}
+	noAdded, err := MulDiv(amount, pool.NoReserve, weight)
+	if err != nil {
+		return nil, err
+	}
+	lpShares, err := MulDiv(amount, pool.LPSupply, weight)
+	if err != nil {
+		return nil, err
+	}
+	if lpShares == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	yesReserve, err := addU64(pool.YesReserve, yesAdded)
+	if err != nil {
+		return nil, err
+	}
+	noReserve, err := addU64(pool.NoReserve, noAdded)
+	if err != nil {
+		return nil, err
+	}
+	lpSupply, err := addU64(pool.LPSupply, lpShares)
+	if err != nil {
+		return nil, err
+	}
+	pool.YesReserve, pool.NoReserve, pool.LPSupply = yesReserve, noReserve, lpSupply
+
+	return &LiquidityChange{
+		Collateral: amount,
+		LPShares:   lpShares,
+		YesShares:  amount - yesAdded,
+		NoShares:   amount - noAdded,
+	}, nil
+}
+
+// RemoveLiquidity burns LP shares and withdraws the provider's part of the pool.
+// Matching YES and NO shares are merged back into collateral; the excess of
+// one side is left to the provider.
+func (c *ConstantProduct) RemoveLiquidity(market *entities.Market, lpShares uint64) (*LiquidityChange, error) {
+	pool := &market.Pool
+	if lpShares == 0 || lpShares > pool.LPSupply {
+		return nil, ErrInvalidShares
+	}
+
+	yesOut, err := MulDiv(lpShares, pool.YesReserve, pool.LPSupply)
+	if err != nil {
+		return nil, err
+	}
+	noOut, err := MulDiv(lpShares, pool.NoReserve, pool.LPSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.YesReserve -= yesOut
+	pool.NoReserve -= noOut
+	pool.LPSupply -= lpShares
+
+	merged := yesOut
+	if noOut < merged {
+		merged = noOut
+	}
+
+	return &LiquidityChange{
+		Collateral: merged,
+		LPShares:   lpShares,
+		YesShares:  yesOut - merged,
+		NoShares:   noOut - merged,
+	}, nil
+}
+
+// PendingFees returns the fees earned by a liquidity position since its last settlement
+func PendingFees(pool *entities.Pool, lp *entities.LiquidityPosition) (uint64, error) {
+	accrued, err := MulDiv(lp.LPShares, pool.AccFeePerShare, entities.FeePrecision)
+	if err != nil {
+		return 0, err
+	}
+	if accrued < lp.FeeDebt {
+		return 0, nil
+	}
+	return accrued - lp.FeeDebt, nil
+}
+
+// SettleFees moves pending fees into the position's claimable balance.
+// It must be called before LPShares changes, followed by ResetFeeDebt.
+func SettleFees(pool *entities.Pool, lp *entities.LiquidityPosition) error {
+	pending, err := PendingFees(pool, lp)
+	if err != nil {
+		return err
+	}
+	lp.ClaimableFees += pending
+	return nil
+}
+
+// ResetFeeDebt marks all fees up to now as settled for the position's current LPShares
+func ResetFeeDebt(pool *entities.Pool, lp *entities.LiquidityPosition) error {
+	debt, err := MulDiv(lp.LPShares, pool.AccFeePerShare, entities.FeePrecision)
+	if err != nil {
+		return err
+	}
+	lp.FeeDebt = debt
+	return nil
+}
+
+// accrueFee distributes a trading fee to all current LP shares
+func accrueFee(pool *entities.Pool, fee uint64) error {
+	if fee == 0 || pool.LPSupply == 0 {
+		return nil
+	}
+	perShare, err := MulDiv(fee, entities.FeePrecision, pool.LPSupply)
+	if err != nil {
+		return err
+	}
+	accFeePerShare, err := addU64(pool.AccFeePerShare, perShare)
+	if err != nil {
+		return err
+	}
+	fees, err := addU64(pool.Fees, fee)
+	if err != nil {
+		return err
+	}
+	pool.AccFeePerShare, pool.Fees = accFeePerShare, fees
+	return nil
+}
+
+// validatePoolOutcome rejects outcomes other than YES and NO, the only ones a pool holds
+func validatePoolOutcome(market *entities.Market, outcome uint8) error {
+	if market.IsCategorical() {
+		return ErrUnsupportedPricing
+	}
+	return market.ValidateOutcome(outcome)
+}
+
+// poolReserves returns the reserve of outcome and of the opposite outcome
+func poolReserves(pool *entities.Pool, outcome uint8) (uint64, uint64) {
+	if outcome == entities.SideYesValue {
+		return pool.YesReserve, pool.NoReserve
+	}
+	return pool.NoReserve, pool.YesReserve
+}
+
+// setPoolReserves sets the reserve of outcome and of the opposite outcome
+func setPoolReserves(pool *entities.Pool, outcome uint8, own, other uint64) {
+	if outcome == entities.SideYesValue {
+		pool.YesReserve, pool.NoReserve = own, other
+	} else {
+		pool.NoReserve, pool.YesReserve = own, other
+	}
+}
+
+// addU64 returns a + b, or ErrArithmeticOverflow if the sum does not fit in a uint64
+func addU64(a, b uint64) (uint64, error) {
+	if a > math.MaxUint64-b {
+		return 0, ErrArithmeticOverflow
+	}
+	return a + b, nil
+}
+
+func u64(v uint64) *big.Int {
+	return new(big.Int).SetUint64(v)
+}
+
+// sqrtCeil returns the smallest integer s with s*s >= n
+func sqrtCeil(n *big.Int) *big.Int {
+	s := new(big.Int).Sqrt(n)
+	if new(big.Int).Mul(s, s).Cmp(n) < 0 {
+		s.Add(s, big.NewInt(1))
+	}
+	return s
+}
+
+// divCeil returns ceil(a / b) for non-negative a and positive b
+func divCeil(a, b *big.Int) *big.Int {
+	q, m := new(big.Int).QuoRem(a, b, new(big.Int))
+	if m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
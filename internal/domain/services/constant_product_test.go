@@ -0,0 +1,243 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func newPoolMarket(yes, no uint64, feeBps uint16) *entities.Market {
+	return &entities.Market{
+		PricingModel: entities.PricingConstantProduct,
+		Pool: entities.Pool{
+			YesReserve: yes,
+			NoReserve:  no,
+			LPSupply:   yes,
+			FeeBps:     feeBps,
+		},
+	}
+}
+
+func poolProduct(pool *entities.Pool) *big.Int {
+	return new(big.Int).Mul(u64(pool.YesReserve), u64(pool.NoReserve))
+}
+
+func TestConstantProductPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		yes, no uint64
+		wantYes uint64
+	}{
+		{"balanced", 1_000, 1_000, PriceScale / 2},
+		{"yes scarce", 1_000, 3_000, PriceScale * 3 / 4},
+		{"yes plentiful", 9_000, 1_000, PriceScale / 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := newPoolMarket(tt.yes, tt.no, 0)
+			yes, err := NewConstantProduct().Price(market, entities.SideYesValue)
+			if err != nil {
+				t.Fatalf("Price(YES) error = %v", err)
+			}
+			no, err := NewConstantProduct().Price(market, entities.SideNoValue)
+			if err != nil {
+				t.Fatalf("Price(NO) error = %v", err)
+			}
+			if yes != tt.wantYes {
+				t.Fatalf("Price(YES) = %d, want %d", yes, tt.wantYes)
+			}
+			if yes+no > PriceScale || yes+no < PriceScale-1 {
+				t.Fatalf("prices sum to %d, want %d", yes+no, PriceScale)
+			}
+		})
+	}
+}
+
+func TestConstantProductTradesKeepInvariant(t *testing.T) {
+	tests := []struct {
+		name    string
+		outcome uint8
+		shares  uint64
+		sell    bool
+		feeBps  uint16
+	}{
+		{"buy yes", entities.SideYesValue, 400_000, false, 0},
+		{"buy no with fee", entities.SideNoValue, 123_457, false, 200},
+		{"buy one unit", entities.SideYesValue, 1, false, 100},
+		{"sell yes", entities.SideYesValue, 250_000, true, 0},
+		{"sell no with fee", entities.SideNoValue, 77_777, true, 300},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := newPoolMarket(1_000_000, 1_000_000, tt.feeBps)
+			before := poolProduct(&market.Pool)
+
+			var quote *Quote
+			var err error
+			if tt.sell {
+				quote, err = NewConstantProduct().Sell(market, tt.outcome, tt.shares)
+			} else {
+				quote, err = NewConstantProduct().Buy(market, tt.outcome, tt.shares)
+			}
+			if err != nil {
+				t.Fatalf("trade error = %v", err)
+			}
+
+			if after := poolProduct(&market.Pool); after.Cmp(before) < 0 {
+				t.Fatalf("reserve product fell from %v to %v", before, after)
+			}
+			if market.Pool.Fees != quote.Fee {
+				t.Fatalf("pool fees = %d, want %d", market.Pool.Fees, quote.Fee)
+			}
+			if tt.feeBps > 0 && quote.Fee == 0 {
+				t.Fatal("no fee charged")
+			}
+		})
+	}
+}
+
+func TestConstantProductBuyCostsAtLeastPrice(t *testing.T) {
+	market := newPoolMarket(1_000_000, 1_000_000, 0)
+	quote, err := NewConstantProduct().QuoteBuy(market, entities.SideYesValue, 1_000)
+	if err != nil {
+		t.Fatalf("QuoteBuy() error = %v", err)
+	}
+	// The marginal price is one half, and buying pushes it up
+	if quote.Cost < 500 || quote.Cost > 502 {
+		t.Fatalf("QuoteBuy() cost = %d, want about 500", quote.Cost)
+	}
+}
+
+func TestConstantProductLiquidity(t *testing.T) {
+	cp := NewConstantProduct()
+	market := &entities.Market{PricingModel: entities.PricingConstantProduct}
+
+	first, err := cp.AddLiquidity(market, 1_000_000)
+	if err != nil {
+		t.Fatalf("AddLiquidity() error = %v", err)
+	}
+	if first.LPShares != 1_000_000 || first.YesShares != 0 || first.NoShares != 0 {
+		t.Fatalf("first AddLiquidity() = %+v, want 1000000 LP shares and no leftovers", first)
+	}
+
+	if _, err := cp.Buy(market, entities.SideYesValue, 300_000); err != nil {
+		t.Fatalf("Buy() error = %v", err)
+	}
+
+	// The pool now holds more NO than YES, so a provider keeps the YES excess
+	second, err := cp.AddLiquidity(market, 100_000)
+	if err != nil {
+		t.Fatalf("AddLiquidity() error = %v", err)
+	}
+	if second.NoShares != 0 || second.YesShares == 0 {
+		t.Fatalf("second AddLiquidity() = %+v, want YES shares left over", second)
+	}
+
+	removed, err := cp.RemoveLiquidity(market, second.LPShares)
+	if err != nil {
+		t.Fatalf("RemoveLiquidity() error = %v", err)
+	}
+	// Adding and removing at the same reserves gives back the collateral, counting
+	// the leftover YES and NO shares merged into complete sets, less rounding
+	sets := second.YesShares + removed.YesShares
+	if removed.NoShares < sets {
+		sets = removed.NoShares
+	}
+	if got := removed.Collateral + sets; got > second.Collateral || got+2 < second.Collateral {
+		t.Fatalf("got back %d after adding %d", got, second.Collateral)
+	}
+	if market.Pool.LPSupply != first.LPShares {
+		t.Fatalf("LP supply = %d, want %d", market.Pool.LPSupply, first.LPShares)
+	}
+}
+
+func TestConstantProductErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		market  *entities.Market
+		run     func(*ConstantProduct, *entities.Market) error
+		wantErr error
+	}{
+		{
+			name:   "empty pool",
+			market: &entities.Market{},
+			run: func(c *ConstantProduct, m *entities.Market) error {
+				_, err := c.QuoteBuy(m, entities.SideYesValue, 1)
+				return err
+			},
+			wantErr: ErrInsufficientLiquidity,
+		},
+		{
+			name:   "categorical market",
+			market: &entities.Market{Outcomes: []string{"A", "B", "C"}},
+			run: func(c *ConstantProduct, m *entities.Market) error {
+				_, err := c.Price(m, 0)
+				return err
+			},
+			wantErr: ErrUnsupportedPricing,
+		},
+		{
+			name:   "reserve overflow",
+			market: newPoolMarket(math.MaxUint64-10, 1_000, 0),
+			run: func(c *ConstantProduct, m *entities.Market) error {
+				_, err := c.Buy(m, entities.SideNoValue, 500)
+				return err
+			},
+			wantErr: ErrArithmeticOverflow,
+		},
+		{
+			name:   "remove more than supply",
+			market: newPoolMarket(1_000, 1_000, 0),
+			run: func(c *ConstantProduct, m *entities.Market) error {
+				_, err := c.RemoveLiquidity(m, 1_001)
+				return err
+			},
+			wantErr: ErrInvalidShares,
+		},
+		{
+			name:   "add nothing",
+			market: newPoolMarket(1_000, 1_000, 0),
+			run: func(c *ConstantProduct, m *entities.Market) error {
+				_, err := c.AddLiquidity(m, 0)
+				return err
+			},
+			wantErr: ErrInvalidShares,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(NewConstantProduct(), tt.market); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPoolFeesPerShare(t *testing.T) {
+	pool := &entities.Pool{LPSupply: 1_000}
+	lp := &entities.LiquidityPosition{LPShares: 250}
+
+	if err := accrueFee(pool, 400); err != nil {
+		t.Fatalf("accrueFee() error = %v", err)
+	}
+	pending, err := PendingFees(pool, lp)
+	if err != nil {
+		t.Fatalf("PendingFees() error = %v", err)
+	}
+	if pending != 100 {
+		t.Fatalf("PendingFees() = %d, want 100", pending)
+	}
+
+	if err := SettleFees(pool, lp); err != nil {
+		t.Fatalf("SettleFees() error = %v", err)
+	}
+	if err := ResetFeeDebt(pool, lp); err != nil {
+		t.Fatalf("ResetFeeDebt() error = %v", err)
+	}
+	if pending, _ := PendingFees(pool, lp); pending != 0 || lp.ClaimableFees != 100 {
+		t.Fatalf("after settling: pending %d, claimable %d, want 0 and 100", pending, lp.ClaimableFees)
+	}
+}
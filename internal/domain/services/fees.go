@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// MaxMarketFeeBps caps the protocol and creator fees of a market combined.
+// Constant-product pool fees paid to liquidity providers are capped separately.
+const MaxMarketFeeBps uint16 = 500
+
+var (
+	ErrFeeTooHigh = errors.New("market fees exceed the maximum")
+	ErrNoFees     = errors.New("no fees to withdraw")
+)
+
+// FeeVault moves trading fees held by a market's fee vaults, one per FeeRecipient.
+// Amounts are in base units of the market's collateral.
+type FeeVault interface {
+	// Collect transfers fees from the market's vault into the recipient's fee vault
+	Collect(ctx context.Context, market *entities.Market, recipient entities.FeeRecipient, amount uint64) error
+	// Withdraw transfers fees from the recipient's fee vault to destination
+	Withdraw(ctx context.Context, market *entities.Market, recipient entities.FeeRecipient, destination string, amount uint64) error
+}
+
+// TradingFees are the fees charged on one trade
+type TradingFees struct {
+	Protocol uint64
+	Creator  uint64
+}
+
+// Total returns the protocol and creator fees combined
+func (f TradingFees) Total() uint64 {
+	return f.Protocol + f.Creator
+}
+
+// ValidateProtocolFee checks the protocol fee set in the program config against MaxMarketFeeBps
+func ValidateProtocolFee(feeBps uint16) error {
+	if feeBps > MaxMarketFeeBps {
+		return ErrFeeTooHigh
+	}
+	return nil
+}
+
+// ValidateMarketFees checks a market's fees against MaxMarketFeeBps
+func ValidateMarketFees(market *entities.Market) error {
+	if uint32(market.ProtocolFeeBps)+uint32(market.CreatorFeeBps) > uint32(MaxMarketFeeBps) {
+		return ErrFeeTooHigh
+	}
+	return nil
+}
+
+// ComputeFees returns the market's fees charged on top of a buy costing amount.
+// Each fee is rounded up so buyers never underpay.
+func ComputeFees(market *entities.Market, amount uint64) (TradingFees, error) {
+	return computeFees(market, amount, MulDivCeil)
+}
+
+// DeductFees returns the market's fees taken out of amount paid to a seller,
+// and what is left for the seller. Each fee is rounded down so the fees never
+// exceed the proceeds.
+func DeductFees(market *entities.Market, amount uint64) (uint64, TradingFees, error) {
+	fees, err := computeFees(market, amount, MulDiv)
+	if err != nil {
+		return 0, TradingFees{}, err
+	}
+	if fees.Total() > amount {
+		return 0, TradingFees{}, ErrFeeTooHigh
+	}
+	return amount - fees.Total(), fees, nil
+}
+
+func computeFees(market *entities.Market, amount uint64, mulDiv func(a, b, c uint64) (uint64, error)) (TradingFees, error) {
+	protocol, err := mulDiv(amount, uint64(market.ProtocolFeeBps), BasisPoints)
+	if err != nil {
+		return TradingFees{}, err
+	}
+	creator, err := mulDiv(amount, uint64(market.CreatorFeeBps), BasisPoints)
+	if err != nil {
+		return TradingFees{}, err
+	}
+	if protocol > math.MaxUint64-creator {
+		return TradingFees{}, ErrArithmeticOverflow
+	}
+	return TradingFees{Protocol: protocol, Creator: creator}, nil
+}
+
+// AccrueFees records fees collected into the market's fee vaults
+func AccrueFees(market *entities.Market, fees TradingFees) error {
+	if market.ProtocolFees > math.MaxUint64-fees.Protocol || market.CreatorFees > math.MaxUint64-fees.Creator {
+		return ErrArithmeticOverflow
+	}
+	market.ProtocolFees += fees.Protocol
+	market.CreatorFees += fees.Creator
+	return nil
+}
+
+// CollectFees moves fees already deposited into the market's vault to its fee vaults
+func CollectFees(ctx context.Context, feeVault FeeVault, market *entities.Market, fees TradingFees) error {
+	if fees.Protocol > 0 {
+		if err := feeVault.Collect(ctx, market, entities.FeeRecipientProtocol, fees.Protocol); err != nil {
+			return err
+		}
+	}
+	if fees.Creator > 0 {
+		if err := feeVault.Collect(ctx, market, entities.FeeRecipientCreator, fees.Creator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func TestComputeFees(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol uint16
+		creator  uint16
+		amount   uint64
+		want     TradingFees
+	}{
+		{"no fees", 0, 0, 1_000, TradingFees{}},
+		{"split", 100, 50, 10_000, TradingFees{Protocol: 100, Creator: 50}},
+		{"rounds up", 100, 50, 101, TradingFees{Protocol: 2, Creator: 1}},
+		{"tiny amount", 1, 1, 1, TradingFees{Protocol: 1, Creator: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := &entities.Market{ProtocolFeeBps: tt.protocol, CreatorFeeBps: tt.creator}
+			got, err := ComputeFees(market, tt.amount)
+			if err != nil {
+				t.Fatalf("ComputeFees() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("ComputeFees() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeductFees(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol uint16
+		creator  uint16
+		amount   uint64
+		wantNet  uint64
+		want     TradingFees
+	}{
+		{"split", 100, 50, 10_000, 9_850, TradingFees{Protocol: 100, Creator: 50}},
+		{"rounds down", 100, 50, 199, 198, TradingFees{Protocol: 1, Creator: 0}},
+		{"dust pays no fees", 100, 100, 99, 99, TradingFees{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := &entities.Market{ProtocolFeeBps: tt.protocol, CreatorFeeBps: tt.creator}
+			net, fees, err := DeductFees(market, tt.amount)
+			if err != nil {
+				t.Fatalf("DeductFees() error = %v", err)
+			}
+			if net != tt.wantNet || fees != tt.want {
+				t.Fatalf("DeductFees() = %d, %+v, want %d, %+v", net, fees, tt.wantNet, tt.want)
+			}
+			if net+fees.Total() != tt.amount {
+				t.Fatalf("DeductFees() split %d into %d and %d", tt.amount, net, fees.Total())
+			}
+		})
+	}
+}
+
+func TestValidateMarketFees(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol uint16
+		creator  uint16
+		wantErr  error
+	}{
+		{"at the cap", MaxMarketFeeBps - 100, 100, nil},
+		{"above the cap", MaxMarketFeeBps, 1, ErrFeeTooHigh},
+		{"no uint16 wraparound", math.MaxUint16, 2, ErrFeeTooHigh},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := &entities.Market{ProtocolFeeBps: tt.protocol, CreatorFeeBps: tt.creator}
+			if err := ValidateMarketFees(market); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateMarketFees() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccrueFees(t *testing.T) {
+	market := &entities.Market{ProtocolFees: 10, CreatorFees: 20}
+	if err := AccrueFees(market, TradingFees{Protocol: 1, Creator: 2}); err != nil {
+		t.Fatalf("AccrueFees() error = %v", err)
+	}
+	if market.ProtocolFees != 11 || market.CreatorFees != 22 {
+		t.Fatalf("fees = %d/%d, want 11/22", market.ProtocolFees, market.CreatorFees)
+	}
+
+	market.CreatorFees = math.MaxUint64
+	if err := AccrueFees(market, TradingFees{Creator: 1}); !errors.Is(err, ErrArithmeticOverflow) {
+		t.Fatalf("AccrueFees() error = %v, want %v", err, ErrArithmeticOverflow)
+	}
+}
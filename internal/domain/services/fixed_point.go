@@ -0,0 +1,101 @@
+package services
+
+import "math/big"
+
+// fixedBits is the number of fractional bits of the fixed-point numbers the
+// LMSR cost function is evaluated in. A fixed-point value x is stored as the
+// integer x * 2^fixedBits, so every validator computes the same result.
+const fixedBits = 128
+
+var (
+	fixedOne = new(big.Int).Lsh(big.NewInt(1), fixedBits)
+	fixedLn2 = computeLn2()
+
+	// fixedEpsilon bounds the absolute error of fixedExpNeg and fixedLn for the
+	// inputs the LMSR uses (2^-96); results are widened by it before rounding
+	// so the rounding direction always holds
+	fixedEpsilon = new(big.Int).Lsh(big.NewInt(1), fixedBits-96)
+)
+
+// fixedFromRatio returns a/b in fixed point, rounded down
+func fixedFromRatio(a, b *big.Int) *big.Int {
+	n := new(big.Int).Lsh(a, fixedBits)
+	return n.Quo(n, b)
+}
+
+// fixedMul returns x*y for fixed-point x and y, rounded down
+func fixedMul(x, y *big.Int) *big.Int {
+	z := new(big.Int).Mul(x, y)
+	return z.Rsh(z, fixedBits)
+}
+
+// fixedFloor returns the integer part of a non-negative fixed-point x
+func fixedFloor(x *big.Int) *big.Int {
+	return new(big.Int).Rsh(x, fixedBits)
+}
+
+// fixedCeil returns the smallest integer not below a non-negative fixed-point x
+func fixedCeil(x *big.Int) *big.Int {
+	n := new(big.Int).Add(x, new(big.Int).Sub(fixedOne, big.NewInt(1)))
+	return n.Rsh(n, fixedBits)
+}
+
+// fixedExpNeg returns exp(-x) for a non-negative fixed-point x, rounded down.
+// x is split as k*ln(2) + r with 0 <= r < ln(2), so exp(-x) = 2^-k / exp(r)
+// and exp(r) is summed from its Taylor series, whose terms are all positive.
+func fixedExpNeg(x *big.Int) *big.Int {
+	k := new(big.Int).Quo(x, fixedLn2)
+	if !k.IsInt64() || k.Int64() >= fixedBits {
+		// Below the smallest representable value
+		return new(big.Int)
+	}
+	r := new(big.Int).Sub(x, new(big.Int).Mul(k, fixedLn2))
+
+	sum := new(big.Int).Set(fixedOne)
+	term := new(big.Int).Set(fixedOne)
+	for n := int64(1); ; n++ {
+		term = fixedMul(term, r)
+		term.Quo(term, big.NewInt(n))
+		if term.Sign() == 0 {
+			break
+		}
+		sum.Add(sum, term)
+	}
+
+	result := new(big.Int).Lsh(fixedOne, fixedBits)
+	result.Quo(result, sum)
+	return result.Rsh(result, uint(k.Int64()))
+}
+
+// fixedLn returns ln(x) for a fixed-point x >= 1, rounded down.
+// x is split as 2^k * y with 1 <= y < 2, so ln(x) = k*ln(2) + ln(y), and
+// ln(y) = 2*atanh(z) with z = (y-1)/(y+1) < 1/3 is summed from its series.
+func fixedLn(x *big.Int) *big.Int {
+	k := x.BitLen() - 1 - fixedBits
+	if k < 0 {
+		return new(big.Int)
+	}
+	y := new(big.Int).Rsh(x, uint(k))
+
+	z := fixedFromRatio(new(big.Int).Sub(y, fixedOne), new(big.Int).Add(y, fixedOne))
+	z2 := fixedMul(z, z)
+	sum := new(big.Int)
+	power := new(big.Int).Set(z)
+	for n := int64(1); power.Sign() > 0; n += 2 {
+		sum.Add(sum, new(big.Int).Quo(power, big.NewInt(n)))
+		power = fixedMul(power, z2)
+	}
+	sum.Lsh(sum, 1)
+
+	return sum.Add(sum, new(big.Int).Mul(big.NewInt(int64(k)), fixedLn2))
+}
+
+// computeLn2 evaluates ln(2) = sum_{n>=1} 1 / (n * 2^n) in fixed point
+func computeLn2() *big.Int {
+	sum := new(big.Int)
+	for n := int64(1); n <= fixedBits; n++ {
+		term := new(big.Int).Rsh(fixedOne, uint(n))
+		sum.Add(sum, term.Quo(term, big.NewInt(n)))
+	}
+	return sum
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// toFloat converts a fixed-point value to float64 for comparison with the math package
+func toFloat(x *big.Int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(x), new(big.Float).SetInt(fixedOne)).Float64()
+	return f
+}
+
+func fromFloat(f float64) *big.Int {
+	x, _ := new(big.Float).Mul(big.NewFloat(f), new(big.Float).SetInt(fixedOne)).Int(nil)
+	return x
+}
+
+func TestFixedLn2(t *testing.T) {
+	if got := toFloat(fixedLn2); math.Abs(got-math.Ln2) > 1e-15 {
+		t.Fatalf("ln(2) = %v, want %v", got, math.Ln2)
+	}
+}
+
+func TestFixedExpNeg(t *testing.T) {
+	tests := []struct {
+		name string
+		x    float64
+	}{
+		{"zero", 0},
+		{"small", 0.001},
+		{"below ln2", 0.5},
+		{"one", 1},
+		{"several ln2", 7.3},
+		{"large", 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toFloat(fixedExpNeg(fromFloat(tt.x)))
+			want := math.Exp(-tt.x)
+			if math.Abs(got-want) > 1e-15*math.Max(1, want) {
+				t.Fatalf("exp(-%v) = %v, want %v", tt.x, got, want)
+			}
+		})
+	}
+}
+
+func TestFixedExpNegUnderflow(t *testing.T) {
+	x := new(big.Int).Mul(fixedOne, big.NewInt(200))
+	if got := fixedExpNeg(x); got.Sign() != 0 {
+		t.Fatalf("exp(-200) = %v, want 0", got)
+	}
+}
+
+func TestFixedLn(t *testing.T) {
+	tests := []struct {
+		name string
+		x    float64
+	}{
+		{"one", 1},
+		{"just above one", 1.0001},
+		{"two", 2},
+		{"three", 3},
+		{"sixteen", 16},
+		{"large", 12345.678},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toFloat(fixedLn(fromFloat(tt.x)))
+			want := math.Log(tt.x)
+			if math.Abs(got-want) > 1e-12 {
+				t.Fatalf("ln(%v) = %v, want %v", tt.x, got, want)
+			}
+		})
+	}
+}
+
+func TestFixedRounding(t *testing.T) {
+	half := new(big.Int).Rsh(fixedOne, 1)
+	x := new(big.Int).Add(new(big.Int).Mul(fixedOne, big.NewInt(3)), half)
+
+	if got := fixedFloor(x); got.Int64() != 3 {
+		t.Fatalf("floor(3.5) = %v, want 3", got)
+	}
+	if got := fixedCeil(x); got.Int64() != 4 {
+		t.Fatalf("ceil(3.5) = %v, want 4", got)
+	}
+	exact := new(big.Int).Mul(fixedOne, big.NewInt(3))
+	if got := fixedCeil(exact); got.Int64() != 3 {
+		t.Fatalf("ceil(3) = %v, want 3", got)
+	}
+}
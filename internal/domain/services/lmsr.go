@@ -0,0 +1,230 @@
+package services
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// LMSR implements the logarithmic market scoring rule for markets with N outcomes.
+//
+// The cost function is C(q) = b * ln(sum_i exp(q_i/b)) where b is the market's
+// Liquidity and q are the outstanding share quantities of each outcome. Buying
+// shares costs C(q_after) - C(q_before), and the marginal price of outcome i is
+// exp(q_i/b) / sum_j exp(q_j/b). The market creator's worst-case loss is
+// b * ln(N), which is b * ln(2) for YES/NO markets.
+//
+// The cost function is evaluated in integer fixed point (see fixed_point.go) so
+// every validator prices a trade identically, and costs are rounded in the
+// market maker's favour.
+type LMSR struct{}
+
+// NewLMSR creates a new LMSR pricing engine
+func NewLMSR() *LMSR {
+	return &LMSR{}
+}
+
+var _ PricingEngine = (*LMSR)(nil)
+
+// Price returns the current marginal price of outcome in collateral base units per full share
+func (l *LMSR) Price(market *entities.Market, outcome uint8) (uint64, error) {
+	if market.Liquidity == 0 {
+		return 0, ErrInvalidLiquidity
+	}
+	if err := market.ValidateOutcome(outcome); err != nil {
+		return 0, err
+	}
+
+	weights, sum := lmsrWeights(market.Liquidity, outcomeQuantities(market))
+
+	// exp(q_i/b) / sum_j exp(q_j/b), rounded to the nearest price unit
+	price := new(big.Int).Mul(weights[outcome], u64(2*PriceScale))
+	price.Add(price, sum)
+	price.Quo(price, new(big.Int).Lsh(sum, 1))
+	return price.Uint64(), nil
+}
+
+// QuoteBuy returns the cost of buying shares of outcome without changing the market
+func (l *LMSR) QuoteBuy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	if market.Liquidity == 0 {
+		return nil, ErrInvalidLiquidity
+	}
+	if err := market.ValidateOutcome(outcome); err != nil {
+		return nil, err
+	}
+	if shares == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	quantities := outcomeQuantities(market)
+	if quantities[outcome] > math.MaxUint64-shares {
+		return nil, ErrArithmeticOverflow
+	}
+
+	// Round against the trader so the market maker never loses to rounding
+	before := lmsrCost(market.Liquidity, quantities, false)
+	quantities[outcome] += shares
+	after := lmsrCost(market.Liquidity, quantities, true)
+
+	delta := after.Sub(after, before)
+	if !delta.IsUint64() {
+		return nil, ErrArithmeticOverflow
+	}
+	cost := delta.Uint64()
+
+	averagePrice, err := AveragePrice(cost, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quote{
+		Outcome:      outcome,
+		Shares:       shares,
+		Cost:         cost,
+		AveragePrice: averagePrice,
+	}, nil
+}
+
+// Buy prices the trade and updates the market's outstanding shares
+func (l *LMSR) Buy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	quote, err := l.QuoteBuy(market, outcome, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	market.SetOutstandingShares(outcome, market.OutstandingShares(outcome)+shares)
+	return quote, nil
+}
+
+// QuoteSell returns the proceeds of selling shares of outcome without changing the market
+func (l *LMSR) QuoteSell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	if market.Liquidity == 0 {
+		return nil, ErrInvalidLiquidity
+	}
+	if err := market.ValidateOutcome(outcome); err != nil {
+		return nil, err
+	}
+	if shares == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	quantities := outcomeQuantities(market)
+	if shares > quantities[outcome] {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	// Round against the trader so the market maker never loses to rounding
+	before := lmsrCost(market.Liquidity, quantities, false)
+	quantities[outcome] -= shares
+	after := lmsrCost(market.Liquidity, quantities, true)
+
+	delta := before.Sub(before, after)
+	if delta.Sign() < 0 {
+		delta.SetInt64(0)
+	}
+	if !delta.IsUint64() {
+		return nil, ErrArithmeticOverflow
+	}
+	proceeds := delta.Uint64()
+
+	averagePrice, err := MulDiv(proceeds, PriceScale, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quote{
+		Outcome:      outcome,
+		Shares:       shares,
+		Cost:         proceeds,
+		AveragePrice: averagePrice,
+	}, nil
+}
+
+// Sell prices the trade and updates the market's outstanding shares
+func (l *LMSR) Sell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	quote, err := l.QuoteSell(market, outcome, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	market.SetOutstandingShares(outcome, market.OutstandingShares(outcome)-shares)
+	return quote, nil
+}
+
+// Subsidy returns the collateral the creator must lock so every payout is covered,
+// i.e. the worst-case market maker loss b * ln(outcomes) rounded up
+func (l *LMSR) Subsidy(liquidity uint64, outcomes int) (uint64, error) {
+	if liquidity == 0 {
+		return 0, ErrInvalidLiquidity
+	}
+	if outcomes < 1 {
+		return 0, ErrInvalidShares
+	}
+
+	// Widen ln(N) by the fixed-point error so the subsidy is never short
+	ln := fixedLn(new(big.Int).Mul(fixedOne, big.NewInt(int64(outcomes))))
+	ln.Add(ln, fixedEpsilon)
+	subsidy := fixedCeil(ln.Mul(ln, u64(liquidity)))
+	if !subsidy.IsUint64() {
+		return 0, ErrArithmeticOverflow
+	}
+	return subsidy.Uint64(), nil
+}
+
+// lmsrCost evaluates b * ln(sum_i exp(q_i/b)) in collateral base units, using
+// log-sum-exp in fixed point: with m the largest quantity, the cost is
+// m + b * ln(sum_i exp((q_i - m)/b)). The fixed-point error is absorbed before
+// rounding, so the result is an upper bound of the exact cost when roundUp is
+// set and a lower bound otherwise.
+func lmsrCost(b uint64, quantities []uint64, roundUp bool) *big.Int {
+	_, sum := lmsrWeights(b, quantities)
+
+	ln := fixedLn(sum)
+	if roundUp {
+		ln.Add(ln, fixedEpsilon)
+	} else if ln.Sub(ln, fixedEpsilon); ln.Sign() < 0 {
+		ln.SetInt64(0)
+	}
+
+	cost := ln.Mul(u64(b), ln)
+	if roundUp {
+		cost = fixedCeil(cost)
+	} else {
+		cost = fixedFloor(cost)
+	}
+	return cost.Add(cost, u64(maxQuantity(quantities)))
+}
+
+// lmsrWeights returns exp((q_i - m)/b) for every outcome, with m the largest
+// quantity, and their sum, in fixed point. The largest weight is exactly 1.
+func lmsrWeights(b uint64, quantities []uint64) ([]*big.Int, *big.Int) {
+	m := maxQuantity(quantities)
+	weights := make([]*big.Int, len(quantities))
+	sum := new(big.Int)
+	for i, q := range quantities {
+		weights[i] = fixedExpNeg(fixedFromRatio(u64(m-q), u64(b)))
+		sum.Add(sum, weights[i])
+	}
+	return weights, sum
+}
+
+// maxQuantity returns the largest outstanding quantity
+func maxQuantity(quantities []uint64) uint64 {
+	var m uint64
+	for _, q := range quantities {
+		if q > m {
+			m = q
+		}
+	}
+	return m
+}
+
+// outcomeQuantities returns a copy of the outstanding shares of every outcome
+func outcomeQuantities(market *entities.Market) []uint64 {
+	quantities := make([]uint64, market.OutcomeCount())
+	for i := range quantities {
+		quantities[i] = market.OutstandingShares(uint8(i))
+	}
+	return quantities
+}
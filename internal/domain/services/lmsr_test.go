@@ -0,0 +1,271 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func newLMSRMarket(liquidity uint64, outcomes ...string) *entities.Market {
+	return &entities.Market{
+		Liquidity:     liquidity,
+		PricingModel:  entities.PricingLMSR,
+		Outcomes:      outcomes,
+		OutcomeShares: make([]uint64, len(outcomes)),
+	}
+}
+
+// lmsrCostFloat evaluates the LMSR cost function in floating point
+func lmsrCostFloat(b float64, quantities []float64) float64 {
+	var sum float64
+	for _, q := range quantities {
+		sum += math.Exp(q / b)
+	}
+	return b * math.Log(sum)
+}
+
+func TestLMSRSubsidy(t *testing.T) {
+	tests := []struct {
+		name      string
+		liquidity uint64
+		outcomes  int
+	}{
+		{"binary", 1_000_000_000, 2},
+		{"three outcomes", 1_000_000_000, 3},
+		{"sixteen outcomes", 5_000_000, 16},
+		{"small liquidity", 7, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLMSR().Subsidy(tt.liquidity, tt.outcomes)
+			if err != nil {
+				t.Fatalf("Subsidy() error = %v", err)
+			}
+			want := float64(tt.liquidity) * math.Log(float64(tt.outcomes))
+			// Rounded up, never short of the worst-case loss
+			if float64(got) < want || float64(got) > math.Ceil(want)+1 {
+				t.Fatalf("Subsidy() = %d, want ceil(%f)", got, want)
+			}
+		})
+	}
+}
+
+func TestLMSRSubsidyErrors(t *testing.T) {
+	if _, err := NewLMSR().Subsidy(0, 2); !errors.Is(err, ErrInvalidLiquidity) {
+		t.Fatalf("Subsidy(0) error = %v, want %v", err, ErrInvalidLiquidity)
+	}
+	if _, err := NewLMSR().Subsidy(100, 0); !errors.Is(err, ErrInvalidShares) {
+		t.Fatalf("Subsidy(outcomes=0) error = %v, want %v", err, ErrInvalidShares)
+	}
+}
+
+func TestLMSRPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		market *entities.Market
+	}{
+		{"empty binary", &entities.Market{Liquidity: 1_000_000}},
+		{"skewed binary", &entities.Market{Liquidity: 1_000_000, YesShares: 2_500_000, NoShares: 300_000}},
+		{"categorical", &entities.Market{
+			Liquidity:     1_000_000,
+			Outcomes:      []string{"A", "B", "C"},
+			OutcomeShares: []uint64{100_000, 4_000_000, 0},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantities := outcomeQuantities(tt.market)
+			var sum float64
+			for _, q := range quantities {
+				sum += math.Exp(float64(q) / float64(tt.market.Liquidity))
+			}
+
+			var total uint64
+			for i, q := range quantities {
+				got, err := NewLMSR().Price(tt.market, uint8(i))
+				if err != nil {
+					t.Fatalf("Price(%d) error = %v", i, err)
+				}
+				want := math.Exp(float64(q)/float64(tt.market.Liquidity)) / sum * float64(PriceScale)
+				if math.Abs(float64(got)-want) > 1 {
+					t.Fatalf("Price(%d) = %d, want %f", i, got, want)
+				}
+				total += got
+			}
+			// Prices are each rounded to the nearest unit
+			if diff := int64(total) - int64(PriceScale); diff < -int64(len(quantities)) || diff > int64(len(quantities)) {
+				t.Fatalf("prices sum to %d, want %d", total, PriceScale)
+			}
+		})
+	}
+}
+
+func TestLMSRQuoteBuyRoundsUp(t *testing.T) {
+	tests := []struct {
+		name    string
+		market  *entities.Market
+		outcome uint8
+		shares  uint64
+	}{
+		{"binary yes", &entities.Market{Liquidity: 1_000_000_000}, entities.SideYesValue, 500_000_000},
+		{"binary no after yes", &entities.Market{Liquidity: 1_000_000_000, YesShares: 3_000_000_000}, entities.SideNoValue, 1_000_000},
+		{"one share unit", &entities.Market{Liquidity: 1_000_000_000}, entities.SideYesValue, 1},
+		{"categorical", newLMSRMarket(2_000_000, "A", "B", "C", "D"), 2, 5_000_000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := outcomeQuantities(tt.market)
+			quote, err := NewLMSR().QuoteBuy(tt.market, tt.outcome, tt.shares)
+			if err != nil {
+				t.Fatalf("QuoteBuy() error = %v", err)
+			}
+
+			b := float64(tt.market.Liquidity)
+			q := make([]float64, len(before))
+			for i, v := range before {
+				q[i] = float64(v)
+			}
+			costBefore := lmsrCostFloat(b, q)
+			q[tt.outcome] += float64(tt.shares)
+			want := lmsrCostFloat(b, q) - costBefore
+
+			// Never below the exact cost, and at most the rounding of both ends above it
+			if float64(quote.Cost) < want-1e-6*want || float64(quote.Cost) > want+2+1e-9*want {
+				t.Fatalf("QuoteBuy() cost = %d, want about %f", quote.Cost, want)
+			}
+			if got := outcomeQuantities(tt.market); got[tt.outcome] != before[tt.outcome] {
+				t.Fatal("QuoteBuy() changed the market")
+			}
+		})
+	}
+}
+
+func TestLMSRRoundTripNeverPaysOut(t *testing.T) {
+	market := &entities.Market{Liquidity: 1_000_000_000}
+	lmsr := NewLMSR()
+
+	for _, shares := range []uint64{1, 3, 999, 1_000_000, 750_000_000} {
+		buy, err := lmsr.Buy(market, entities.SideYesValue, shares)
+		if err != nil {
+			t.Fatalf("Buy(%d) error = %v", shares, err)
+		}
+		sell, err := lmsr.Sell(market, entities.SideYesValue, shares)
+		if err != nil {
+			t.Fatalf("Sell(%d) error = %v", shares, err)
+		}
+		if sell.Cost > buy.Cost {
+			t.Fatalf("selling %d shares paid %d, more than the %d they cost", shares, sell.Cost, buy.Cost)
+		}
+	}
+	if market.YesShares != 0 || market.NoShares != 0 {
+		t.Fatalf("outstanding shares = %d/%d, want 0/0", market.YesShares, market.NoShares)
+	}
+}
+
+// TestLMSRSolvency checks that the subsidy plus everything paid for shares
+// covers the payout of whichever outcome wins
+func TestLMSRSolvency(t *testing.T) {
+	tests := []struct {
+		name   string
+		market *entities.Market
+		trades []struct {
+			outcome uint8
+			shares  uint64
+			sell    bool
+		}
+	}{
+		{
+			name:   "binary",
+			market: &entities.Market{Liquidity: 1_000_000},
+			trades: []struct {
+				outcome uint8
+				shares  uint64
+				sell    bool
+			}{
+				{entities.SideYesValue, 5_000_000, false},
+				{entities.SideNoValue, 1, false},
+				{entities.SideYesValue, 2_000_000, true},
+				{entities.SideNoValue, 9_000_000, false},
+				{entities.SideYesValue, 7, false},
+			},
+		},
+		{
+			name:   "categorical",
+			market: newLMSRMarket(333, "A", "B", "C"),
+			trades: []struct {
+				outcome uint8
+				shares  uint64
+				sell    bool
+			}{
+				{0, 1, false},
+				{1, 10_000, false},
+				{2, 999, false},
+				{1, 4_321, true},
+				{0, 1, false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lmsr := NewLMSR()
+			vault, err := lmsr.Subsidy(tt.market.Liquidity, tt.market.OutcomeCount())
+			if err != nil {
+				t.Fatalf("Subsidy() error = %v", err)
+			}
+
+			for _, trade := range tt.trades {
+				if trade.sell {
+					quote, err := lmsr.Sell(tt.market, trade.outcome, trade.shares)
+					if err != nil {
+						t.Fatalf("Sell() error = %v", err)
+					}
+					vault -= quote.Cost
+				} else {
+					quote, err := lmsr.Buy(tt.market, trade.outcome, trade.shares)
+					if err != nil {
+						t.Fatalf("Buy() error = %v", err)
+					}
+					vault += quote.Cost
+				}
+
+				for i := 0; i < tt.market.OutcomeCount(); i++ {
+					if owed := tt.market.OutstandingShares(uint8(i)); owed > vault {
+						t.Fatalf("outcome %d owes %d with only %d in the vault", i, owed, vault)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLMSRErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		market  *entities.Market
+		outcome uint8
+		shares  uint64
+		sell    bool
+		wantErr error
+	}{
+		{"no liquidity", &entities.Market{}, entities.SideYesValue, 1, false, ErrInvalidLiquidity},
+		{"zero shares", &entities.Market{Liquidity: 100}, entities.SideYesValue, 0, false, ErrInvalidShares},
+		{"unknown outcome", &entities.Market{Liquidity: 100}, 2, 1, false, entities.ErrInvalidOutcome},
+		{"overflow", &entities.Market{Liquidity: 100, YesShares: math.MaxUint64}, entities.SideYesValue, 1, false, ErrArithmeticOverflow},
+		{"sell more than outstanding", &entities.Market{Liquidity: 100, YesShares: 5}, entities.SideYesValue, 6, true, ErrInsufficientLiquidity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.sell {
+				_, err = NewLMSR().QuoteSell(tt.market, tt.outcome, tt.shares)
+			} else {
+				_, err = NewLMSR().QuoteBuy(tt.market, tt.outcome, tt.shares)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
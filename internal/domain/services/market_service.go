@@ -5,28 +5,48 @@ import (
 	"errors"
 	"time"
 	"github.com/polymarket/solana-program/internal/domain/entities"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+	"github.com/polymarket/solana-program/pkg/utils"
 )
 
 var (
 	ErrMarketNotFound      = errors.New("market not found")
 	ErrInvalidMarketStatus = errors.New("invalid market status")
 	ErrMarketClosed        = errors.New("market is closed")
+	ErrTradingEnded        = errors.New("trading ended at the market's end date")
 	ErrUnauthorized        = errors.New("unauthorized")
 )
 
 // MarketService defines business logic for markets
 type MarketService interface {
 	CreateMarket(ctx context.Context, market *entities.Market) error
-	ResolveMarket(ctx context.Context, marketID string, resolution entities.MarketResolution, resolver string) error
+	ResolveMarket(ctx context.Context, marketID string, resolution entities.MarketResolution, outcome uint8, resolver string) error
+	ResolveScalar(ctx context.Context, marketID string, value int64, resolver string) error
 	CloseMarket(ctx context.Context, marketID string) error
 	ValidateMarket(ctx context.Context, market *entities.Market) error
 }
 
+// CheckTradingOpen returns ErrMarketClosed unless the market is open and
+// ErrTradingEnded once now reaches its end date. Trades made after the end date
+// could be placed by someone who already knows the outcome.
+func CheckTradingOpen(market *entities.Market, now time.Time) error {
+	if market.Status != entities.StatusOpen {
+		return ErrMarketClosed
+	}
+	if !now.Before(market.EndDate) {
+		return ErrTradingEnded
+	}
+	return nil
+}
+
 // MarketValidator validates market business rules
 type MarketValidator struct{}
 
 // ValidateMarket validates market creation rules
 func (v *MarketValidator) ValidateMarket(ctx context.Context, market *entities.Market) error {
+	if err := utils.ValidateID(market.ID); err != nil {
+		return err
+	}
 	if market.Title == "" {
 		return errors.New("market title is required")
 	}
@@ -36,6 +56,56 @@ func (v *MarketValidator) ValidateMarket(ctx context.Context, market *entities.M
 	if market.Creator == "" {
 		return errors.New("market creator is required")
 	}
+	switch market.PricingModel {
+	case entities.PricingLMSR, "":
+		if market.Liquidity == 0 {
+			return ErrInvalidLiquidity
+		}
+	case entities.PricingConstantProduct:
+		// The pool starts empty and is funded through AddLiquidity
+		if market.IsCategorical() {
+			return ErrUnsupportedPricing
+		}
+		if market.Pool.FeeBps > MaxPoolFeeBps {
+			return ErrInvalidPoolFee
+		}
+	default:
+		return entities.ErrInvalidPricingModel
+	}
+	if err := ValidateMarketFees(market); err != nil {
+		return err
+	}
+	if market.IsCategorical() {
+		if err := entities.ValidateOutcomes(market.Outcomes); err != nil {
+			return err
+		}
+	}
+	if market.IsScalar() {
+		// Scalar markets trade LONG and SHORT, never named outcomes
+		if market.IsCategorical() {
+			return entities.ErrInvalidOutcomes
+		}
+		if err := market.Scalar.Validate(); err != nil {
+			return err
+		}
+	}
+	if market.IsOracleBacked() {
+		if err := ValidateOracle(market); err != nil {
+			return err
+		}
+	}
+	if market.IsOptimistic() {
+		// Proposals replace the creator, so they cannot be combined with an oracle
+		if market.IsOracleBacked() {
+			return entities.ErrInvalidOptimisticConfig
+		}
+		if err := market.Optimistic.Validate(); err != nil {
+			return err
+		}
+		if _, err := solanautils.PublicKeyFromString(market.Optimistic.Arbiter); err != nil {
+			return entities.ErrInvalidOptimisticConfig
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrOptimisticMarket    = errors.New("market is resolved through bonded proposals")
+	ErrNotOptimisticMarket = errors.New("market is not resolved through bonded proposals")
+	ErrMarketNotEnded      = errors.New("market has not reached its end date")
+	ErrDisputeWindowOpen   = errors.New("dispute window has not ended")
+	ErrDisputeWindowClosed = errors.New("dispute window has ended")
+	ErrSelfDispute         = errors.New("proposer cannot dispute their own proposal")
+)
+
+// ValidateReportedResolution checks a resolution reported for a market,
+// including scalar values that ValidateResolution leaves to ResolveScalar
+func ValidateReportedResolution(market *entities.Market, resolution entities.MarketResolution, outcome uint8) error {
+	if resolution == entities.ResolutionScalar {
+		if !market.IsScalar() {
+			return entities.ErrNotScalarMarket
+		}
+		return nil
+	}
+	return market.ValidateResolution(resolution, outcome)
+}
+
+// ApplyResolution resolves the market to the reported resolution
+func ApplyResolution(market *entities.Market, resolution entities.MarketResolution, outcome uint8, value int64) error {
+	if err := ValidateReportedResolution(market, resolution, outcome); err != nil {
+		return err
+	}
+
+	market.Resolution = resolution
+	market.ResolvedOutcome = outcome
+	if resolution == entities.ResolutionScalar {
+		market.ResolvedValue = value
+	}
+	market.Status = entities.StatusResolved
+	return nil
+}
+
+// DisputeBonds returns the bonds posted on a disputed proposal, paid out in
+// full to whichever side the arbiter decides for
+func DisputeBonds(config *entities.OptimisticConfig) (uint64, error) {
+	if config.Bond > math.MaxUint64/2 {
+		return 0, ErrArithmeticOverflow
+	}
+	return 2 * config.Bond, nil
+}
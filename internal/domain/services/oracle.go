@@ -0,0 +1,151 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	solanautils "github.com/polymarket/solana-program/pkg/solana"
+)
+
+// MaxPriceExponent bounds the decimal exponents oracle prices are expressed in
+const MaxPriceExponent int32 = 18
+
+var (
+	ErrOracleMarket      = errors.New("market is resolved by its oracle")
+	ErrNotOracleMarket   = errors.New("market has no oracle")
+	ErrUnsupportedOracle = errors.New("oracle kind not supported by the market")
+	ErrOracleNotReady    = errors.New("oracle has no valid price published after the market end date")
+	ErrNotFirstPrice     = errors.New("price is not the first the feed published at or after the market end date")
+	ErrWrongPriceFeed    = errors.New("price was published by another feed")
+	ErrOracleQuorum      = errors.New("not enough committee members signed the resolution")
+	ErrOracleUncertain   = errors.New("oracle confidence interval straddles the threshold")
+)
+
+// OracleReport is a resolution derived from a market's oracle
+type OracleReport struct {
+	Resolution entities.MarketResolution
+	Outcome    uint8 // Winning outcome index when Resolution is ResolutionOutcome
+	Value      int64 // Resolved value when Resolution is ResolutionScalar
+}
+
+// ValidateOracle checks the oracle of an oracle-backed market at creation
+func ValidateOracle(market *entities.Market) error {
+	oracle := market.Oracle
+	if oracle.Kind.Uint8() == entities.OracleNoneValue {
+		return entities.ErrInvalidOracleKind
+	}
+	if _, err := solanautils.PublicKeyFromString(oracle.Account); err != nil {
+		return entities.ErrInvalidOracle
+	}
+	if oracle.Exponent < -MaxPriceExponent || oracle.Exponent > MaxPriceExponent {
+		return entities.ErrInvalidOracle
+	}
+	// A price cannot pick one of several named outcomes
+	if oracle.Kind == entities.OraclePriceFeed && market.IsCategorical() {
+		return ErrUnsupportedOracle
+	}
+	return nil
+}
+
+// ReportFromPriceFeed derives a market's resolution from a price of its feed.
+// The price must be verified and be the first the feed published at or after
+// the market's end date, so the resolution does not depend on when it is
+// reported. Scalar markets resolve to the price; YES/NO markets resolve YES when
+// the price is at or above the oracle's threshold and NO otherwise, and reject
+// prices whose confidence interval straddles the threshold.
+func ReportFromPriceFeed(market *entities.Market, feed *entities.PriceFeed) (*OracleReport, error) {
+	if !market.IsOracleBacked() || market.Oracle.Kind != entities.OraclePriceFeed {
+		return nil, ErrUnsupportedOracle
+	}
+	if feed.FeedID != market.Oracle.Account {
+		return nil, ErrWrongPriceFeed
+	}
+	if !feed.Verified || feed.PublishTime.Before(market.EndDate) {
+		return nil, ErrOracleNotReady
+	}
+	if !feed.PrevPublishTime.Before(market.EndDate) {
+		return nil, ErrNotFirstPrice
+	}
+
+	price, err := ScalePrice(feed.Price, feed.Exponent, market.Oracle.Exponent)
+	if err != nil {
+		return nil, err
+	}
+
+	if market.IsScalar() {
+		return &OracleReport{Resolution: entities.ResolutionScalar, Value: price}, nil
+	}
+	if market.IsCategorical() {
+		return nil, ErrUnsupportedOracle
+	}
+
+	// Both ends of the confidence interval must fall on the same side of the threshold
+	low, high, err := confidenceBounds(feed)
+	if err != nil {
+		return nil, err
+	}
+	if low, err = ScalePrice(low, feed.Exponent, market.Oracle.Exponent); err != nil {
+		return nil, err
+	}
+	if high, err = ScalePrice(high, feed.Exponent, market.Oracle.Exponent); err != nil {
+		return nil, err
+	}
+	if (low >= market.Oracle.Threshold) != (high >= market.Oracle.Threshold) {
+		return nil, ErrOracleUncertain
+	}
+
+	if price >= market.Oracle.Threshold {
+		return &OracleReport{Resolution: entities.ResolutionYes}, nil
+	}
+	return &OracleReport{Resolution: entities.ResolutionNo}, nil
+}
+
+// confidenceBounds returns the feed's price minus and plus its confidence interval
+func confidenceBounds(feed *entities.PriceFeed) (int64, int64, error) {
+	if feed.Confidence > math.MaxInt64 {
+		return 0, 0, ErrArithmeticOverflow
+	}
+	confidence := int64(feed.Confidence)
+	if feed.Price < math.MinInt64+confidence || feed.Price > math.MaxInt64-confidence {
+		return 0, 0, ErrArithmeticOverflow
+	}
+	return feed.Price - confidence, feed.Price + confidence, nil
+}
+
+// CheckCommitteeQuorum checks that at least the committee's threshold of distinct members are among signers
+func CheckCommitteeQuorum(committee *entities.Committee, signers []string) error {
+	if err := committee.Validate(); err != nil {
+		return err
+	}
+
+	signed := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		if committee.IsMember(signer) {
+			signed[signer] = true
+		}
+	}
+	if len(signed) < int(committee.Threshold) {
+		return ErrOracleQuorum
+	}
+	return nil
+}
+
+// ScalePrice converts price from units of 10^from to units of 10^to,
+// truncating toward zero when precision is lost
+func ScalePrice(price int64, from, to int32) (int64, error) {
+	if from < -MaxPriceExponent || from > MaxPriceExponent || to < -MaxPriceExponent || to > MaxPriceExponent {
+		return 0, entities.ErrInvalidOracle
+	}
+
+	for ; from > to; from-- {
+		if price > math.MaxInt64/10 || price < math.MinInt64/10 {
+			return 0, ErrArithmeticOverflow
+		}
+		price *= 10
+	}
+	for ; from < to; from++ {
+		price /= 10
+	}
+	return price, nil
+}
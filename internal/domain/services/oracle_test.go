@@ -0,0 +1,122 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func TestReportFromPriceFeed(t *testing.T) {
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feedID := "8qJSyQprMC57TWKaYEmetUR3UUiTP2M3hXdcvFhkZdmv"
+	binary := &entities.Market{
+		EndDate: end,
+		Oracle:  &entities.Oracle{Kind: entities.OraclePriceFeed, Account: feedID, Threshold: 50_000, Exponent: 0},
+	}
+	scalar := &entities.Market{
+		EndDate: end,
+		Scalar:  &entities.ScalarRange{Min: 0, Max: 100_000},
+		Oracle:  &entities.Oracle{Kind: entities.OraclePriceFeed, Account: feedID, Exponent: 0},
+	}
+	// feed returns a verified price published at publish, one second after the feed's previous price
+	feed := func(price int64, confidence uint64, publish time.Time) *entities.PriceFeed {
+		// Feed prices carry two more decimals than the markets compare in
+		return &entities.PriceFeed{
+			FeedID:          feedID,
+			Price:           price * 100,
+			Confidence:      confidence * 100,
+			Exponent:        -2,
+			PublishTime:     publish,
+			PrevPublishTime: publish.Add(-time.Second),
+			Verified:        true,
+		}
+	}
+	late := feed(40_000, 10, end.Add(30*24*time.Hour))
+	late.PrevPublishTime = end.Add(-time.Hour)
+	unverified := feed(60_000, 10, end)
+	unverified.Verified = false
+	otherFeed := feed(60_000, 10, end)
+	otherFeed.FeedID = "11111111111111111111111111111111"
+
+	tests := []struct {
+		name    string
+		market  *entities.Market
+		feed    *entities.PriceFeed
+		want    *OracleReport
+		wantErr error
+	}{
+		{"above threshold", binary, feed(60_000, 10, end), &OracleReport{Resolution: entities.ResolutionYes}, nil},
+		{"at threshold", binary, feed(50_000, 0, end.Add(time.Second/2)), &OracleReport{Resolution: entities.ResolutionYes}, nil},
+		{"below threshold", binary, feed(40_000, 10, end), &OracleReport{Resolution: entities.ResolutionNo}, nil},
+		{"first price after a long gap", binary, late, &OracleReport{Resolution: entities.ResolutionNo}, nil},
+		{"scalar value", scalar, feed(12_345, 1_000, end), &OracleReport{Resolution: entities.ResolutionScalar, Value: 12_345}, nil},
+		{"published before the end date", binary, feed(60_000, 10, end.Add(-time.Second)), nil, ErrOracleNotReady},
+		{"not the first price after the end date", binary, feed(60_000, 10, end.Add(time.Second)), nil, ErrNotFirstPrice},
+		{"unverified", binary, unverified, nil, ErrOracleNotReady},
+		{"another feed", binary, otherFeed, nil, ErrWrongPriceFeed},
+		{"confidence straddles threshold", binary, feed(50_005, 10, end), nil, ErrOracleUncertain},
+		{"not a price feed market", &entities.Market{EndDate: end}, feed(60_000, 0, end), nil, ErrUnsupportedOracle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReportFromPriceFeed(tt.market, tt.feed)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReportFromPriceFeed() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != nil && (got == nil || *got != *tt.want) {
+				t.Fatalf("ReportFromPriceFeed() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScalePrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		price    int64
+		from, to int32
+		want     int64
+		wantErr  error
+	}{
+		{"same exponent", 123, -2, -2, 123, nil},
+		{"more decimals", 123, -2, -4, 12_300, nil},
+		{"fewer decimals truncates", 12_399, -4, -2, 123, nil},
+		{"negative truncates toward zero", -12_399, -4, -2, -123, nil},
+		{"overflow", 1 << 62, 0, -1, 0, ErrArithmeticOverflow},
+		{"exponent out of range", 1, MaxPriceExponent + 1, 0, 0, entities.ErrInvalidOracle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ScalePrice(tt.price, tt.from, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ScalePrice() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ScalePrice() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCommitteeQuorum(t *testing.T) {
+	committee := &entities.Committee{Members: []string{"a", "b", "c"}, Threshold: 2}
+	tests := []struct {
+		name    string
+		signers []string
+		wantErr error
+	}{
+		{"quorum", []string{"a", "c"}, nil},
+		{"duplicate signer", []string{"a", "a"}, ErrOracleQuorum},
+		{"outsider", []string{"a", "x"}, ErrOracleQuorum},
+		{"nobody", nil, ErrOracleQuorum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckCommitteeQuorum(committee, tt.signers); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckCommitteeQuorum() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrInvalidOrderPrice = errors.New("order price must be between 1 and PriceScale")
+	ErrOrderNotOpen      = errors.New("order is not open")
+	ErrOrderOutcome      = errors.New("order outcome does not match the user's position")
+)
+
+// ValidateOrder checks the limit price and size of a new order
+func ValidateOrder(market *entities.Market, order *entities.Order) error {
+	if err := market.ValidateOutcome(order.Outcome); err != nil {
+		return err
+	}
+	if order.Side != entities.OrderBuy && order.Side != entities.OrderSell {
+		return entities.ErrInvalidOrderSide
+	}
+	if order.Price == 0 || order.Price > PriceScale {
+		return ErrInvalidOrderPrice
+	}
+	if order.Size == 0 {
+		return ErrInvalidShares
+	}
+	return nil
+}
+
+// OrderEscrow returns the collateral a buy order locks in the market vault:
+// its full size at its limit price, rounded up
+func OrderEscrow(order *entities.Order) (uint64, error) {
+	if order.Side != entities.OrderBuy {
+		return 0, nil
+	}
+	return MulDivCeil(order.Size, order.Price, PriceScale)
+}
+
+// MatchOrder matches an incoming taker order against resting orders with
+// price-time priority: the best price first, and among equal prices the
+// earliest Sequence. Trades execute at the maker's price and may fill either
+// order partially. Filled, Status and Escrow are updated on every order
+// involved; orders of the taker's own user are skipped.
+func MatchOrder(taker *entities.Order, resting []*entities.Order) ([]*entities.Fill, error) {
+	book := make([]*entities.Order, 0, len(resting))
+	for _, maker := range resting {
+		if maker.IsOpen() && maker.MarketID == taker.MarketID && maker.Outcome == taker.Outcome &&
+			maker.Side == taker.Side.Opposite() && maker.UserID != taker.UserID && crosses(taker, maker) {
+			book = append(book, maker)
+		}
+	}
+
+	sort.SliceStable(book, func(i, j int) bool {
+		if book[i].Price != book[j].Price {
+			// Buyers prefer the cheapest sells, sellers the highest bids
+			if taker.Side == entities.OrderBuy {
+				return book[i].Price < book[j].Price
+			}
+			return book[i].Price > book[j].Price
+		}
+		return book[i].Sequence < book[j].Sequence
+	})
+
+	fills := make([]*entities.Fill, 0)
+	for _, maker := range book {
+		if taker.Remaining() == 0 {
+			break
+		}
+
+		shares := taker.Remaining()
+		if maker.Remaining() < shares {
+			shares = maker.Remaining()
+		}
+		cost, err := MulDiv(shares, maker.Price, PriceScale)
+		if err != nil {
+			return nil, err
+		}
+
+		buy, sell := taker, maker
+		if taker.Side == entities.OrderSell {
+			buy, sell = maker, taker
+		}
+		if cost > buy.Escrow {
+			return nil, ErrArithmeticOverflow
+		}
+		buy.Escrow -= cost
+
+		for _, order := range []*entities.Order{taker, maker} {
+			order.Filled += shares
+			if order.Remaining() == 0 {
+				order.Status = entities.OrderStatusFilled
+			}
+		}
+
+		fills = append(fills, &entities.Fill{
+			MarketID:     taker.MarketID,
+			Outcome:      taker.Outcome,
+			MakerOrderID: maker.ID,
+			TakerOrderID: taker.ID,
+			Buyer:        buy.UserID,
+			Seller:       sell.UserID,
+			Price:        maker.Price,
+			Shares:       shares,
+			Cost:         cost,
+		})
+	}
+
+	return fills, nil
+}
+
+// crosses reports whether taker's limit price accepts maker's price
+func crosses(taker, maker *entities.Order) bool {
+	if taker.Side == entities.OrderBuy {
+		return maker.Price <= taker.Price
+	}
+	return maker.Price >= taker.Price
+}
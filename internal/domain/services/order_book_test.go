@@ -0,0 +1,166 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func newOrder(id, user string, side entities.OrderSide, price, size, sequence uint64) *entities.Order {
+	order := &entities.Order{
+		ID:       id,
+		MarketID: "market",
+		UserID:   user,
+		Outcome:  entities.SideYesValue,
+		Side:     side,
+		Price:    price,
+		Size:     size,
+		Status:   entities.OrderStatusOpen,
+		Sequence: sequence,
+	}
+	order.Escrow, _ = OrderEscrow(order)
+	return order
+}
+
+func TestValidateOrder(t *testing.T) {
+	market := &entities.Market{}
+	tests := []struct {
+		name    string
+		order   *entities.Order
+		wantErr error
+	}{
+		{"valid buy", newOrder("o", "u", entities.OrderBuy, PriceScale/2, 10, 0), nil},
+		{"price at scale", newOrder("o", "u", entities.OrderSell, PriceScale, 10, 0), nil},
+		{"zero price", newOrder("o", "u", entities.OrderBuy, 0, 10, 0), ErrInvalidOrderPrice},
+		{"price above scale", newOrder("o", "u", entities.OrderBuy, PriceScale+1, 10, 0), ErrInvalidOrderPrice},
+		{"zero size", newOrder("o", "u", entities.OrderBuy, 1, 0, 0), ErrInvalidShares},
+		{"unknown side", newOrder("o", "u", entities.OrderSide("hold"), 1, 1, 0), entities.ErrInvalidOrderSide},
+		{"unknown outcome", &entities.Order{Outcome: 5, Side: entities.OrderBuy, Price: 1, Size: 1}, entities.ErrInvalidOutcome},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateOrder(market, tt.order); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateOrder() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderEscrow(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *entities.Order
+		want  uint64
+	}{
+		{"buy", &entities.Order{Side: entities.OrderBuy, Price: PriceScale / 4, Size: 1_000}, 250},
+		{"buy rounds up", &entities.Order{Side: entities.OrderBuy, Price: PriceScale / 3, Size: 10}, 4},
+		{"sell escrows shares, not collateral", &entities.Order{Side: entities.OrderSell, Price: PriceScale / 2, Size: 1_000}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OrderEscrow(tt.order)
+			if err != nil {
+				t.Fatalf("OrderEscrow() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("OrderEscrow() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchOrder(t *testing.T) {
+	const half = PriceScale / 2
+	tests := []struct {
+		name      string
+		taker     *entities.Order
+		resting   []*entities.Order
+		wantFills []entities.Fill
+		wantOpen  uint64 // Taker share units left unfilled
+	}{
+		{
+			name:  "best price first",
+			taker: newOrder("t", "taker", entities.OrderBuy, half, 300, 10),
+			resting: []*entities.Order{
+				newOrder("expensive", "a", entities.OrderSell, half, 200, 1),
+				newOrder("cheap", "b", entities.OrderSell, half/2, 200, 2),
+			},
+			wantFills: []entities.Fill{
+				{MakerOrderID: "cheap", Buyer: "taker", Seller: "b", Price: half / 2, Shares: 200, Cost: 50},
+				{MakerOrderID: "expensive", Buyer: "taker", Seller: "a", Price: half, Shares: 100, Cost: 50},
+			},
+		},
+		{
+			name:  "time priority at equal prices",
+			taker: newOrder("t", "taker", entities.OrderSell, half, 100, 10),
+			resting: []*entities.Order{
+				newOrder("late", "a", entities.OrderBuy, half, 100, 5),
+				newOrder("early", "b", entities.OrderBuy, half, 100, 3),
+			},
+			wantFills: []entities.Fill{
+				{MakerOrderID: "early", Buyer: "b", Seller: "taker", Price: half, Shares: 100, Cost: 50},
+			},
+		},
+		{
+			name:  "skips non-crossing, own and closed orders",
+			taker: newOrder("t", "taker", entities.OrderBuy, half, 100, 10),
+			resting: []*entities.Order{
+				newOrder("too expensive", "a", entities.OrderSell, half+1, 100, 1),
+				newOrder("own", "taker", entities.OrderSell, 1, 100, 2),
+				func() *entities.Order {
+					o := newOrder("cancelled", "b", entities.OrderSell, 1, 100, 3)
+					o.Status = entities.OrderStatusCancelled
+					return o
+				}(),
+				newOrder("same side", "c", entities.OrderBuy, half, 100, 4),
+			},
+			wantOpen: 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			escrow := tt.taker.Escrow
+			fills, err := MatchOrder(tt.taker, tt.resting)
+			if err != nil {
+				t.Fatalf("MatchOrder() error = %v", err)
+			}
+			if len(fills) != len(tt.wantFills) {
+				t.Fatalf("MatchOrder() made %d fills, want %d", len(fills), len(tt.wantFills))
+			}
+
+			var paid uint64
+			for i, want := range tt.wantFills {
+				got := *fills[i]
+				want.MarketID, want.Outcome, want.TakerOrderID = "market", entities.SideYesValue, "t"
+				if got != want {
+					t.Fatalf("fill %d = %+v, want %+v", i, got, want)
+				}
+				paid += got.Cost
+			}
+
+			if tt.taker.Remaining() != tt.wantOpen {
+				t.Fatalf("taker remaining = %d, want %d", tt.taker.Remaining(), tt.wantOpen)
+			}
+			if tt.taker.Side == entities.OrderBuy && tt.taker.Escrow != escrow-paid {
+				t.Fatalf("taker escrow = %d, want %d", tt.taker.Escrow, escrow-paid)
+			}
+			if tt.wantOpen == 0 && tt.taker.Status != entities.OrderStatusFilled {
+				t.Fatalf("taker status = %s, want filled", tt.taker.Status)
+			}
+		})
+	}
+}
+
+func TestMatchOrderNeverOverdrawsEscrow(t *testing.T) {
+	taker := newOrder("t", "taker", entities.OrderSell, 1, 1_000, 10)
+	maker := newOrder("m", "maker", entities.OrderBuy, PriceScale/3, 1_000, 1)
+
+	if _, err := MatchOrder(taker, []*entities.Order{maker}); err != nil {
+		t.Fatalf("MatchOrder() error = %v", err)
+	}
+	// The escrow was rounded up and each fill rounded down, so some is left to refund
+	if maker.Status != entities.OrderStatusFilled || maker.Escrow > 1 {
+		t.Fatalf("maker = %s with escrow %d, want filled with at most 1 left", maker.Status, maker.Escrow)
+	}
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrOutcomeTokensDisabled = errors.New("market does not use outcome tokens")
+	ErrOutcomeTokenMarket    = errors.New("outcome token markets pay out through token redemption")
+)
+
+// OutcomeTokens mints and burns a market's outcome tokens, one mint per outcome.
+// Each token base unit is one share unit; the mints are owned by the market PDA.
+type OutcomeTokens interface {
+	// CreateMints initializes a mint for every outcome of the market
+	CreateMints(ctx context.Context, market *entities.Market) error
+	// Mint issues amount tokens of outcome to owner
+	Mint(ctx context.Context, market *entities.Market, outcome uint8, owner string, amount uint64) error
+	// Burn destroys amount tokens of outcome held by owner
+	Burn(ctx context.Context, market *entities.Market, outcome uint8, owner string, amount uint64) error
+}
+
+// RedeemValue returns the collateral paid for amount outcome tokens in a resolved market.
+// Tokens pay their SettlementValue; in a cancelled market every outcome pays an equal
+// share, so a complete set is always worth its collateral less rounding.
+func RedeemValue(market *entities.Market, outcome uint8, amount uint64) (uint64, error) {
+	if !market.OutcomeTokens {
+		return 0, ErrOutcomeTokensDisabled
+	}
+	if market.Status != entities.StatusResolved {
+		return 0, ErrMarketNotResolved
+	}
+	if err := market.ValidateOutcome(outcome); err != nil {
+		return 0, err
+	}
+	if amount == 0 {
+		return 0, ErrInvalidShares
+	}
+
+	var value uint64
+	if market.Resolution == entities.ResolutionCancelled {
+		value = amount / uint64(market.OutcomeCount())
+	} else {
+		var err error
+		if value, err = SettlementValue(market, outcome, amount); err != nil {
+			return 0, err
+		}
+	}
+
+	if value == 0 {
+		return 0, ErrNothingToClaim
+	}
+	return value, nil
+}
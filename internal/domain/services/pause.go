@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrProtocolPaused = errors.New("protocol is paused")
+	ErrMarketFrozen   = errors.New("market is frozen")
+)
+
+// PauseState reports the protocol-wide emergency pause
+type PauseState interface {
+	// IsPaused reports whether the protocol is paused
+	IsPaused(ctx context.Context) (bool, error)
+}
+
+// CheckNotHalted returns ErrProtocolPaused while the protocol is paused and
+// ErrMarketFrozen while the market is frozen. Trading, claim, redemption, fee
+// withdrawal and proposal settlement paths call it before moving any funds;
+// cancelling orders and removing liquidity stay open so users can exit.
+func CheckNotHalted(ctx context.Context, state PauseState, market *entities.Market) error {
+	paused, err := state.IsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return ErrProtocolPaused
+	}
+	if market.Frozen {
+		return ErrMarketFrozen
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// pauseState is a fixed PauseState
+type pauseState struct {
+	paused bool
+	err    error
+}
+
+func (s pauseState) IsPaused(context.Context) (bool, error) {
+	return s.paused, s.err
+}
+
+func TestCheckNotHalted(t *testing.T) {
+	errState := errors.New("config unavailable")
+	tests := []struct {
+		name    string
+		state   pauseState
+		frozen  bool
+		wantErr error
+	}{
+		{"running", pauseState{}, false, nil},
+		{"paused", pauseState{paused: true}, false, ErrProtocolPaused},
+		{"paused and frozen", pauseState{paused: true}, true, ErrProtocolPaused},
+		{"frozen", pauseState{}, true, ErrMarketFrozen},
+		{"state error", pauseState{err: errState}, false, errState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := &entities.Market{Frozen: tt.frozen}
+			if err := CheckNotHalted(context.Background(), tt.state, market); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckNotHalted() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrMarketNotResolved = errors.New("market is not resolved")
+	ErrAlreadyClaimed    = errors.New("position already claimed")
+	ErrNothingToClaim    = errors.New("position has no payout")
+)
+
+// Payout returns the collateral base units owed to a position in a resolved market.
+// Each winning share unit pays 1 base unit, scalar shares pay their share of the
+// resolved value (see SettlementValue), and a cancelled market refunds the
+// position's cost basis: the collateral escrowed for its shares, without the
+// fees, which were paid out to the fee vaults.
+//
+// Cost bases record what was paid, not what the market still holds: traders who
+// sold out at a loss or netted complete sets left less collateral behind than
+// the remaining holders paid. Cancelled refunds are therefore capped at 1/N base
+// unit per share in a market with N outcomes. An LMSR market always holds at
+// least that for every outstanding share, and constant-product pools redeem
+// their own shares at the same value (see RedeemLiquidityShares), so the
+// refunds of every holder are covered whatever order they claim in.
+func Payout(market *entities.Market, position *entities.Position) (uint64, error) {
+	if market.Status != entities.StatusResolved {
+		return 0, ErrMarketNotResolved
+	}
+	if position.Claimed {
+		return 0, ErrAlreadyClaimed
+	}
+
+	var payout uint64
+	if market.Resolution == entities.ResolutionCancelled {
+		payout = position.CostBasis
+		if even := position.Shares / uint64(market.OutcomeCount()); payout > even {
+			payout = even
+		}
+	} else {
+		var err error
+		if payout, err = SettlementValue(market, position.Outcome, position.Shares); err != nil {
+			return 0, err
+		}
+	}
+
+	if payout == 0 {
+		return 0, ErrNothingToClaim
+	}
+	return payout, nil
+}
+
+// SettlementValue returns what shares of outcome pay in a market resolved to an outcome
+// or a scalar value. Winning shares pay 1 base unit each and losing shares nothing.
+// In a scalar market LONG shares pay (value - min) / (max - min) per unit and SHORT
+// shares the rest, with the value clamped to the range and the result rounded down.
+func SettlementValue(market *entities.Market, outcome uint8, shares uint64) (uint64, error) {
+	if market.Resolution == entities.ResolutionScalar {
+		if !market.IsScalar() {
+			return 0, entities.ErrNotScalarMarket
+		}
+		if err := market.ValidateOutcome(outcome); err != nil {
+			return 0, err
+		}
+
+		width := market.Scalar.Width()
+		long := market.Scalar.Offset(market.ResolvedValue)
+		if outcome == entities.OutcomeLong {
+			return MulDiv(shares, long, width)
+		}
+		return MulDiv(shares, width-long, width)
+	}
+
+	winner, ok := market.WinningOutcome()
+	if !ok {
+		return 0, ErrMarketNotResolved
+	}
+	if outcome != winner {
+		return 0, nil
+	}
+	return shares, nil
+}
+
+// RedeemLiquidityShares pays out the outcome shares a liquidity provider was left
+// with by unbalanced adds and removes, once the market is resolved. The shares pay
+// their SettlementValue, or half a base unit each in a cancelled market, and are
+// cleared from the liquidity position.
+// Returns the collateral base units owed to the provider.
+func RedeemLiquidityShares(market *entities.Market, lp *entities.LiquidityPosition) (uint64, error) {
+	if market.Status != entities.StatusResolved {
+		return 0, ErrMarketNotResolved
+	}
+
+	var value uint64
+	for _, held := range []struct {
+		outcome uint8
+		shares  uint64
+	}{
+		{entities.SideYesValue, lp.YesShares},
+		{entities.SideNoValue, lp.NoShares},
+	} {
+		if held.shares == 0 {
+			continue
+		}
+
+		var shareValue uint64
+		if market.Resolution == entities.ResolutionCancelled {
+			shareValue = held.shares / entities.BinaryOutcomes
+		} else {
+			var err error
+			if shareValue, err = SettlementValue(market, held.outcome, held.shares); err != nil {
+				return 0, err
+			}
+		}
+		if value > math.MaxUint64-shareValue {
+			return 0, ErrArithmeticOverflow
+		}
+		value += shareValue
+	}
+
+	lp.YesShares = 0
+	lp.NoShares = 0
+	return value, nil
+}
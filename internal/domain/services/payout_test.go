@@ -0,0 +1,211 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func TestPayout(t *testing.T) {
+	resolved := func(resolution entities.MarketResolution) *entities.Market {
+		return &entities.Market{Status: entities.StatusResolved, Resolution: resolution}
+	}
+	scalar := &entities.Market{
+		Status:        entities.StatusResolved,
+		Resolution:    entities.ResolutionScalar,
+		Scalar:        &entities.ScalarRange{Min: 100, Max: 200},
+		ResolvedValue: 175,
+	}
+
+	tests := []struct {
+		name     string
+		market   *entities.Market
+		position *entities.Position
+		want     uint64
+		wantErr  error
+	}{
+		{"winning yes", resolved(entities.ResolutionYes), &entities.Position{Outcome: entities.SideYesValue, Shares: 700}, 700, nil},
+		{"losing no", resolved(entities.ResolutionYes), &entities.Position{Outcome: entities.SideNoValue, Shares: 700}, 0, ErrNothingToClaim},
+		{"winning no", resolved(entities.ResolutionNo), &entities.Position{Outcome: entities.SideNoValue, Shares: 5}, 5, nil},
+		{
+			"categorical winner",
+			&entities.Market{Status: entities.StatusResolved, Resolution: entities.ResolutionOutcome, ResolvedOutcome: 2, Outcomes: []string{"A", "B", "C"}},
+			&entities.Position{Outcome: 2, Shares: 42},
+			42, nil,
+		},
+		{"scalar long", scalar, &entities.Position{Outcome: entities.OutcomeLong, Shares: 1_000}, 750, nil},
+		{"scalar short", scalar, &entities.Position{Outcome: entities.OutcomeShort, Shares: 1_000}, 250, nil},
+		{
+			"cancelled refunds the cost basis without fees",
+			resolved(entities.ResolutionCancelled),
+			&entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 520, CostBasis: 500},
+			500, nil,
+		},
+		{
+			"cancelled caps the refund at half a unit per share",
+			resolved(entities.ResolutionCancelled),
+			&entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 820, CostBasis: 800},
+			500, nil,
+		},
+		{
+			"cancelled constant product caps the refund at half a unit per share",
+			&entities.Market{Status: entities.StatusResolved, Resolution: entities.ResolutionCancelled, PricingModel: entities.PricingConstantProduct},
+			&entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 820, CostBasis: 800},
+			500, nil,
+		},
+		{
+			"cancelled below even odds refunds the cost basis",
+			&entities.Market{Status: entities.StatusResolved, Resolution: entities.ResolutionCancelled, PricingModel: entities.PricingConstantProduct},
+			&entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 320, CostBasis: 300},
+			300, nil,
+		},
+		{
+			"cancelled categorical caps the refund at a unit per outcome",
+			&entities.Market{Status: entities.StatusResolved, Resolution: entities.ResolutionCancelled, Outcomes: []string{"A", "B", "C", "D"}},
+			&entities.Position{Outcome: 1, Shares: 1_000, Amount: 420, CostBasis: 400},
+			250, nil,
+		},
+		{"already claimed", resolved(entities.ResolutionYes), &entities.Position{Outcome: entities.SideYesValue, Shares: 1, Claimed: true}, 0, ErrAlreadyClaimed},
+		{
+			"not resolved",
+			&entities.Market{Status: entities.StatusClosed, Resolution: entities.ResolutionYes},
+			&entities.Position{Outcome: entities.SideYesValue, Shares: 1},
+			0, ErrMarketNotResolved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Payout(tt.market, tt.position)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Payout() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Payout() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedeemLiquidityShares(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolution entities.MarketResolution
+		yes, no    uint64
+		want       uint64
+	}{
+		{"yes wins", entities.ResolutionYes, 300, 100, 300},
+		{"no wins", entities.ResolutionNo, 300, 100, 100},
+		{"cancelled pays half", entities.ResolutionCancelled, 301, 100, 200},
+		{"nothing left", entities.ResolutionYes, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := &entities.Market{Status: entities.StatusResolved, Resolution: tt.resolution}
+			lp := &entities.LiquidityPosition{YesShares: tt.yes, NoShares: tt.no}
+
+			got, err := RedeemLiquidityShares(market, lp)
+			if err != nil {
+				t.Fatalf("RedeemLiquidityShares() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("RedeemLiquidityShares() = %d, want %d", got, tt.want)
+			}
+			if lp.YesShares != 0 || lp.NoShares != 0 {
+				t.Fatal("RedeemLiquidityShares() left shares on the position")
+			}
+		})
+	}
+}
+
+// buyShares records a buy the way the create position use case does
+func buyShares(t *testing.T, market *entities.Market, position *entities.Position, outcome uint8, shares uint64) {
+	t.Helper()
+
+	quote, err := NewLMSR().Buy(market, outcome, shares)
+	if err != nil {
+		t.Fatalf("Buy() error = %v", err)
+	}
+	fees, err := ComputeFees(market, quote.Cost)
+	if err != nil {
+		t.Fatalf("ComputeFees() error = %v", err)
+	}
+	netted, err := AddToPosition(market, position, outcome, quote.Shares, quote.Cost+fees.Total(), quote.Cost)
+	if err != nil {
+		t.Fatalf("AddToPosition() error = %v", err)
+	}
+	if err := LockCollateral(market, quote.Cost); err != nil {
+		t.Fatalf("LockCollateral() error = %v", err)
+	}
+	if err := RedeemCompleteSets(market, netted); err != nil {
+		t.Fatalf("RedeemCompleteSets() error = %v", err)
+	}
+}
+
+// TestPayoutSolvency checks that the market's collateral covers every payout
+// whichever way the market resolves, including the refunds of a cancelled market
+func TestPayoutSolvency(t *testing.T) {
+	type trade struct {
+		user    int
+		outcome uint8
+		shares  uint64
+	}
+	tests := []struct {
+		name   string
+		trades []trade
+	}{
+		{"one sided", []trade{
+			{0, entities.SideYesValue, 5_000_000},
+			{1, entities.SideYesValue, 3_000_000},
+		}},
+		{"both sides", []trade{
+			{0, entities.SideYesValue, 2_000_000},
+			{1, entities.SideNoValue, 7_000_000},
+			{2, entities.SideYesValue, 1},
+		}},
+		{"netting", []trade{
+			{0, entities.SideYesValue, 4_000_000},
+			{1, entities.SideNoValue, 1_000_000},
+			{0, entities.SideNoValue, 1_500_000},
+			{0, entities.SideNoValue, 6_000_000},
+			{1, entities.SideYesValue, 999_999},
+		}},
+	}
+	for _, tt := range tests {
+		for _, resolution := range []entities.MarketResolution{entities.ResolutionYes, entities.ResolutionNo, entities.ResolutionCancelled} {
+			t.Run(tt.name+"/"+string(resolution), func(t *testing.T) {
+				market := &entities.Market{Liquidity: 1_000_000, ProtocolFeeBps: 100, CreatorFeeBps: 50}
+				subsidy, err := NewLMSR().Subsidy(market.Liquidity, market.OutcomeCount())
+				if err != nil {
+					t.Fatalf("Subsidy() error = %v", err)
+				}
+				if err := LockCollateral(market, subsidy); err != nil {
+					t.Fatalf("LockCollateral() error = %v", err)
+				}
+
+				positions := make([]*entities.Position, 3)
+				for i := range positions {
+					positions[i] = &entities.Position{}
+				}
+				for _, trade := range tt.trades {
+					buyShares(t, market, positions[trade.user], trade.outcome, trade.shares)
+				}
+
+				market.Status = entities.StatusResolved
+				market.Resolution = resolution
+				for i, position := range positions {
+					payout, err := Payout(market, position)
+					if errors.Is(err, ErrNothingToClaim) {
+						continue
+					}
+					if err != nil {
+						t.Fatalf("Payout(%d) error = %v", i, err)
+					}
+					if err := ReleaseCollateral(market, payout); err != nil {
+						t.Fatalf("position %d is owed %d with %d collateral left", i, payout, market.Collateral)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// ErrOppositePosition is returned when a buy cannot be combined with the user's position
+var ErrOppositePosition = errors.New("position holds another outcome; sell it before buying this one")
+
+// ReducePosition removes shares sold for proceeds from a position. The sold
+// shares release their proportional part of the amount paid and of the escrowed
+// cost basis, and the difference between proceeds and the amount paid is added
+// to the position's realised PnL.
+// Returns the PnL realised by this sale.
+func ReducePosition(position *entities.Position, shares, proceeds uint64) (int64, error) {
+	if shares == 0 || shares > position.Shares {
+		return 0, ErrInvalidShares
+	}
+
+	costBasis, err := MulDiv(position.Amount, shares, position.Shares)
+	if err != nil {
+		return 0, err
+	}
+	escrowed, err := EscrowedBasis(position, shares)
+	if err != nil {
+		return 0, err
+	}
+	if proceeds > math.MaxInt64 || costBasis > math.MaxInt64 {
+		return 0, ErrArithmeticOverflow
+	}
+
+	pnl := int64(proceeds) - int64(costBasis)
+	position.Amount -= costBasis
+	position.CostBasis -= escrowed
+	position.Shares -= shares
+	position.RealizedPnL += pnl
+	return pnl, nil
+}
+
+// EscrowedBasis returns the part of the position's cost basis backing shares of it
+func EscrowedBasis(position *entities.Position, shares uint64) (uint64, error) {
+	if shares == 0 || shares > position.Shares {
+		return 0, ErrInvalidShares
+	}
+	return MulDiv(position.CostBasis, shares, position.Shares)
+}
+
+// AddToPosition records a buy of shares of outcome for cost on the user's single
+// position in the market, basis of which was escrowed in the market vault.
+//
+// Buys of the outcome already held aggregate at a weighted-average entry price.
+// In two-outcome markets a buy of the opposite outcome is netted instead: each
+// bought share unit closes one held share unit, the pair forming a complete set
+// worth 1 collateral base unit, and only the excess flips the position to the
+// new outcome. Shares reserved by open sell orders are never netted.
+// Categorical markets reject buying a different outcome with ErrOppositePosition.
+//
+// Returns the number of complete sets netted, whose collateral is owed to the user.
+func AddToPosition(market *entities.Market, position *entities.Position, outcome uint8, shares, cost, basis uint64) (uint64, error) {
+	if shares == 0 || basis > cost {
+		return 0, ErrInvalidShares
+	}
+
+	if position.Shares == 0 {
+		position.Outcome = outcome
+	}
+
+	var netted uint64
+	if position.Outcome != outcome {
+		if market.OutcomeCount() != entities.BinaryOutcomes {
+			return 0, ErrOppositePosition
+		}
+
+		netted = shares
+		if available := position.AvailableShares(); available < netted {
+			netted = available
+		}
+		if shares > netted && position.Shares > netted {
+			// The excess cannot flip a position that keeps reserved shares
+			return 0, ErrOppositePosition
+		}
+
+		// The netted shares were bought for their part of cost and redeem for netted
+		nettedCost, err := MulDiv(cost, netted, shares)
+		if err != nil {
+			return 0, err
+		}
+		nettedBasis, err := MulDiv(basis, netted, shares)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := ReducePosition(position, netted, netted); err != nil {
+			return 0, err
+		}
+		if nettedCost > math.MaxInt64 {
+			return 0, ErrArithmeticOverflow
+		}
+		position.RealizedPnL -= int64(nettedCost)
+
+		shares -= netted
+		cost -= nettedCost
+		basis -= nettedBasis
+		if shares == 0 {
+			return netted, nil
+		}
+		position.Outcome = outcome
+	}
+
+	if position.Amount > math.MaxUint64-cost || position.Shares > math.MaxUint64-shares {
+		return 0, ErrArithmeticOverflow
+	}
+	position.Amount += cost
+	position.CostBasis += basis
+	position.Shares += shares
+
+	price, err := AveragePrice(position.Amount, position.Shares)
+	if err != nil {
+		return 0, err
+	}
+	position.Price = price
+	return netted, nil
+}
+
+// RedeemCompleteSets records sets complete sets of a two-outcome market leaving
+// circulation, e.g. after netting a position. The sets are paid 1 collateral base
+// unit each; LMSR markets also drop them from the outstanding shares, which
+// lowers the market maker's cost function by exactly the collateral paid.
+func RedeemCompleteSets(market *entities.Market, sets uint64) error {
+	if sets == 0 {
+		return nil
+	}
+
+	if market.PricingModel != entities.PricingConstantProduct {
+		for outcome := 0; outcome < market.OutcomeCount(); outcome++ {
+			outstanding := market.OutstandingShares(uint8(outcome))
+			if outstanding < sets {
+				return ErrInsufficientLiquidity
+			}
+			market.SetOutstandingShares(uint8(outcome), outstanding-sets)
+		}
+	}
+
+	return ReleaseCollateral(market, sets)
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func TestAddToPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		market     *entities.Market
+		position   entities.Position
+		outcome    uint8
+		shares     uint64
+		cost       uint64
+		basis      uint64
+		want       entities.Position
+		wantNetted uint64
+		wantErr    error
+	}{
+		{
+			name:       "first buy",
+			market:     &entities.Market{},
+			outcome:    entities.SideYesValue,
+			shares:     1_000,
+			cost:       420,
+			basis:      400,
+			want:       entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 420, CostBasis: 400, Price: 420 * PriceScale / 1_000},
+			wantNetted: 0,
+		},
+		{
+			name:     "same outcome averages",
+			market:   &entities.Market{},
+			position: entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 400, CostBasis: 400},
+			outcome:  entities.SideYesValue,
+			shares:   1_000,
+			cost:     600,
+			basis:    600,
+			want:     entities.Position{Outcome: entities.SideYesValue, Shares: 2_000, Amount: 1_000, CostBasis: 1_000, Price: PriceScale / 2},
+		},
+		{
+			name:       "opposite outcome nets complete sets",
+			market:     &entities.Market{},
+			position:   entities.Position{Outcome: entities.SideYesValue, Shares: 1_000, Amount: 600, CostBasis: 600},
+			outcome:    entities.SideNoValue,
+			shares:     400,
+			cost:       200,
+			basis:      200,
+			want:       entities.Position{Outcome: entities.SideYesValue, Shares: 600, Amount: 360, CostBasis: 360, RealizedPnL: 400 - 240 - 200},
+			wantNetted: 400,
+		},
+		{
+			name:       "opposite outcome flips the position",
+			market:     &entities.Market{},
+			position:   entities.Position{Outcome: entities.SideYesValue, Shares: 100, Amount: 50, CostBasis: 50},
+			outcome:    entities.SideNoValue,
+			shares:     300,
+			cost:       150,
+			basis:      120,
+			want:       entities.Position{Outcome: entities.SideNoValue, Shares: 200, Amount: 100, CostBasis: 80, Price: PriceScale / 2, RealizedPnL: 100 - 50 - 50},
+			wantNetted: 100,
+		},
+		{
+			name:     "locked shares are not netted",
+			market:   &entities.Market{},
+			position: entities.Position{Outcome: entities.SideYesValue, Shares: 100, Locked: 100, Amount: 50, CostBasis: 50},
+			outcome:  entities.SideNoValue,
+			shares:   10,
+			cost:     5,
+			basis:    5,
+			wantErr:  ErrOppositePosition,
+		},
+		{
+			name:     "categorical rejects another outcome",
+			market:   &entities.Market{Outcomes: []string{"A", "B", "C"}},
+			position: entities.Position{Outcome: 0, Shares: 100, Amount: 50, CostBasis: 50},
+			outcome:  1,
+			shares:   10,
+			cost:     5,
+			basis:    5,
+			wantErr:  ErrOppositePosition,
+		},
+		{
+			name:    "basis above cost",
+			market:  &entities.Market{},
+			outcome: entities.SideYesValue,
+			shares:  10,
+			cost:    5,
+			basis:   6,
+			wantErr: ErrInvalidShares,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			position := tt.position
+			netted, err := AddToPosition(tt.market, &position, tt.outcome, tt.shares, tt.cost, tt.basis)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddToPosition() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if netted != tt.wantNetted {
+				t.Fatalf("AddToPosition() netted = %d, want %d", netted, tt.wantNetted)
+			}
+			if position != tt.want {
+				t.Fatalf("position = %+v, want %+v", position, tt.want)
+			}
+		})
+	}
+}
+
+func TestReducePosition(t *testing.T) {
+	position := &entities.Position{Shares: 1_000, Amount: 500, CostBasis: 480}
+
+	pnl, err := ReducePosition(position, 250, 200)
+	if err != nil {
+		t.Fatalf("ReducePosition() error = %v", err)
+	}
+	if pnl != 75 {
+		t.Fatalf("ReducePosition() pnl = %d, want 75", pnl)
+	}
+	want := entities.Position{Shares: 750, Amount: 375, CostBasis: 360, RealizedPnL: 75}
+	if *position != want {
+		t.Fatalf("position = %+v, want %+v", *position, want)
+	}
+
+	if _, err := ReducePosition(position, 751, 0); !errors.Is(err, ErrInvalidShares) {
+		t.Fatalf("ReducePosition() error = %v, want %v", err, ErrInvalidShares)
+	}
+}
+
+func TestRedeemCompleteSets(t *testing.T) {
+	tests := []struct {
+		name    string
+		market  *entities.Market
+		sets    uint64
+		wantYes uint64
+		wantErr error
+	}{
+		{"lmsr drops outstanding shares", &entities.Market{YesShares: 500, NoShares: 300, Collateral: 1_000}, 200, 300, nil},
+		{"pool keeps reserves", &entities.Market{PricingModel: entities.PricingConstantProduct, YesShares: 500, Collateral: 1_000}, 200, 500, nil},
+		{"more sets than outstanding", &entities.Market{YesShares: 500, NoShares: 100, Collateral: 1_000}, 200, 0, ErrInsufficientLiquidity},
+		{"more than the collateral", &entities.Market{YesShares: 500, NoShares: 500, Collateral: 100}, 200, 0, ErrInsufficientVaultBalance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collateral := tt.market.Collateral
+			err := RedeemCompleteSets(tt.market, tt.sets)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RedeemCompleteSets() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.market.YesShares != tt.wantYes || tt.market.Collateral != collateral-tt.sets {
+				t.Fatalf("market = %d YES and %d collateral, want %d and %d", tt.market.YesShares, tt.market.Collateral, tt.wantYes, collateral-tt.sets)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// PriceScale is the number of share units in one full share. Each winning
+// share unit pays 1 base unit of the market's collateral, so prices are
+// expressed in collateral base units per full share, i.e. probability * PriceScale.
+const PriceScale uint64 = 1_000_000_000
+
+// BasisPoints is the denominator for values expressed in basis points
+const BasisPoints uint64 = 10_000
+
+var (
+	ErrInvalidLiquidity      = errors.New("market liquidity parameter must be positive")
+	ErrInvalidShares         = errors.New("share amount must be positive")
+	ErrSlippageExceeded      = errors.New("price moved beyond the allowed slippage")
+	ErrArithmeticOverflow    = errors.New("arithmetic overflow")
+	ErrInsufficientLiquidity = errors.New("insufficient pool liquidity")
+	ErrUnsupportedPricing    = errors.New("operation not supported by the market's pricing model")
+	ErrInvalidPoolFee        = errors.New("pool fee exceeds the maximum")
+)
+
+// Quote is the result of pricing a trade against a market maker
+type Quote struct {
+	Outcome      uint8
+	Shares       uint64 // Share units traded, each paying 1 collateral base unit if it wins
+	Cost         uint64 // Collateral paid on buys, received on sells
+	Fee          uint64 // Part of the trade paid to liquidity providers
+	AveragePrice uint64 // Collateral base units per full share
+}
+
+// PricingEngine quotes trades and updates market state for an automated market maker.
+// Outcomes are indexed as in Market.OutcomeCount; for YES/NO markets the
+// index is the PositionSide wire value.
+type PricingEngine interface {
+	// Price returns the current marginal price of outcome in collateral base units per full share
+	Price(market *entities.Market, outcome uint8) (uint64, error)
+	// QuoteBuy returns the cost of buying shares of outcome without changing the market
+	QuoteBuy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error)
+	// Buy prices the trade and updates the market's outstanding shares
+	Buy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error)
+	// QuoteSell returns the proceeds of selling shares of outcome without changing the market
+	QuoteSell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error)
+	// Sell prices the trade and updates the market's outstanding shares
+	Sell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error)
+}
+
+// PricingRouter dispatches to the pricing engine selected by each market's PricingModel
+type PricingRouter struct {
+	lmsr            PricingEngine
+	constantProduct PricingEngine
+}
+
+// NewPricingRouter creates a new PricingRouter
+func NewPricingRouter(lmsr, constantProduct PricingEngine) *PricingRouter {
+	return &PricingRouter{
+		lmsr:            lmsr,
+		constantProduct: constantProduct,
+	}
+}
+
+var _ PricingEngine = (*PricingRouter)(nil)
+
+func (r *PricingRouter) engine(market *entities.Market) (PricingEngine, error) {
+	switch market.PricingModel {
+	case entities.PricingLMSR, "":
+		return r.lmsr, nil
+	case entities.PricingConstantProduct:
+		return r.constantProduct, nil
+	default:
+		return nil, entities.ErrInvalidPricingModel
+	}
+}
+
+// Price returns the current marginal price of outcome
+func (r *PricingRouter) Price(market *entities.Market, outcome uint8) (uint64, error) {
+	engine, err := r.engine(market)
+	if err != nil {
+		return 0, err
+	}
+	return engine.Price(market, outcome)
+}
+
+// QuoteBuy returns the cost of buying shares of outcome
+func (r *PricingRouter) QuoteBuy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	engine, err := r.engine(market)
+	if err != nil {
+		return nil, err
+	}
+	return engine.QuoteBuy(market, outcome, shares)
+}
+
+// Buy prices the trade and updates the market
+func (r *PricingRouter) Buy(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	engine, err := r.engine(market)
+	if err != nil {
+		return nil, err
+	}
+	return engine.Buy(market, outcome, shares)
+}
+
+// QuoteSell returns the proceeds of selling shares of outcome
+func (r *PricingRouter) QuoteSell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	engine, err := r.engine(market)
+	if err != nil {
+		return nil, err
+	}
+	return engine.QuoteSell(market, outcome, shares)
+}
+
+// Sell prices the trade and updates the market
+func (r *PricingRouter) Sell(market *entities.Market, outcome uint8, shares uint64) (*Quote, error) {
+	engine, err := r.engine(market)
+	if err != nil {
+		return nil, err
+	}
+	return engine.Sell(market, outcome, shares)
+}
+
+// CheckSlippage rejects a buy quote whose average price exceeds expectedPrice by more than maxSlippageBps
+func CheckSlippage(quote *Quote, expectedPrice uint64, maxSlippageBps uint16) error {
+	limit, err := MulDiv(expectedPrice, BasisPoints+uint64(maxSlippageBps), BasisPoints)
+	if err != nil {
+		return err
+	}
+	if quote.AveragePrice > limit {
+		return ErrSlippageExceeded
+	}
+	return nil
+}
+
+// CheckSellSlippage rejects a sell quote whose average price is below expectedPrice by more than maxSlippageBps
+func CheckSellSlippage(quote *Quote, expectedPrice uint64, maxSlippageBps uint16) error {
+	if uint64(maxSlippageBps) > BasisPoints {
+		maxSlippageBps = uint16(BasisPoints)
+	}
+	limit, err := MulDiv(expectedPrice, BasisPoints-uint64(maxSlippageBps), BasisPoints)
+	if err != nil {
+		return err
+	}
+	if quote.AveragePrice < limit {
+		return ErrSlippageExceeded
+	}
+	return nil
+}
+
+// AveragePrice returns the price per full share paid for shares at cost, rounded up
+func AveragePrice(cost, shares uint64) (uint64, error) {
+	if shares == 0 {
+		return 0, ErrInvalidShares
+	}
+	return MulDivCeil(cost, PriceScale, shares)
+}
+
+// MulDiv computes a*b/c rounded down without intermediate overflow
+func MulDiv(a, b, c uint64) (uint64, error) {
+	hi, lo := bits.Mul64(a, b)
+	if c == 0 || hi >= c {
+		return 0, ErrArithmeticOverflow
+	}
+	quo, _ := bits.Div64(hi, lo, c)
+	return quo, nil
+}
+
+// MulDivCeil computes a*b/c rounded up without intermediate overflow
+func MulDivCeil(a, b, c uint64) (uint64, error) {
+	hi, lo := bits.Mul64(a, b)
+	if c == 0 || hi >= c {
+		return 0, ErrArithmeticOverflow
+	}
+	quo, rem := bits.Div64(hi, lo, c)
+	if rem != 0 {
+		if quo == math.MaxUint64 {
+			return 0, ErrArithmeticOverflow
+		}
+		quo++
+	}
+	return quo, nil
+}
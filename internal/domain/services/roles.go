@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// Roles checks keys against the roles granted in the program config
+type Roles interface {
+	// Require returns ErrUnauthorized unless key holds role
+	Require(ctx context.Context, key string, role entities.Role) error
+}
+
+// CheckRole returns ErrUnauthorized unless key holds role in config
+func CheckRole(config *entities.ProgramConfig, key string, role entities.Role) error {
+	if config == nil || !config.HasRole(key, role) {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+// RequireMarketRole authorizes key for a market operation: the market's
+// creator is always allowed, other keys must hold role
+func RequireMarketRole(ctx context.Context, roles Roles, market *entities.Market, key string, role entities.Role) error {
+	if key != "" && key == market.Creator {
+		return nil
+	}
+	return roles.Require(ctx, key, role)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// configRoles checks roles against a fixed program config
+type configRoles struct {
+	config *entities.ProgramConfig
+}
+
+func (r configRoles) Require(_ context.Context, key string, role entities.Role) error {
+	return CheckRole(r.config, key, role)
+}
+
+func TestCheckRole(t *testing.T) {
+	config := &entities.ProgramConfig{Admin: "admin", Resolvers: []string{"resolver"}, Pausers: []string{"pauser"}}
+	tests := []struct {
+		name    string
+		config  *entities.ProgramConfig
+		key     string
+		role    entities.Role
+		wantErr error
+	}{
+		{"admin holds every role", config, "admin", entities.RolePauser, nil},
+		{"resolver", config, "resolver", entities.RoleResolver, nil},
+		{"pauser", config, "pauser", entities.RolePauser, nil},
+		{"resolver cannot pause", config, "resolver", entities.RolePauser, ErrUnauthorized},
+		{"pauser is not admin", config, "pauser", entities.RoleAdmin, ErrUnauthorized},
+		{"empty key", &entities.ProgramConfig{}, "", entities.RoleAdmin, ErrUnauthorized},
+		{"no config", nil, "admin", entities.RoleAdmin, ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckRole(tt.config, tt.key, tt.role); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckRole() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequireMarketRole(t *testing.T) {
+	roles := configRoles{config: &entities.ProgramConfig{Admin: "admin", Resolvers: []string{"resolver"}}}
+	market := &entities.Market{Creator: "creator"}
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{"creator", "creator", nil},
+		{"resolver", "resolver", nil},
+		{"admin", "admin", nil},
+		{"stranger", "stranger", ErrUnauthorized},
+		{"empty key", "", ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RequireMarketRole(context.Background(), roles, market, tt.key, entities.RoleResolver)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RequireMarketRole() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
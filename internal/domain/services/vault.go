@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrInsufficientVaultBalance = errors.New("market vault balance too low")
+	ErrVaultInsolvent           = errors.New("market vault holds less than the market's collateral")
+)
+
+// Vault moves collateral held by a market's vault account.
+// Amounts are in base units of the market's collateral: lamports for native
+// SOL markets, token base units for SPL collateral.
+type Vault interface {
+	// Deposit transfers collateral from depositor into the market's vault
+	Deposit(ctx context.Context, market *entities.Market, depositor string, amount uint64) error
+	// Withdraw transfers collateral from the market's vault to recipient
+	Withdraw(ctx context.Context, market *entities.Market, recipient string, amount uint64) error
+	// Balance returns the collateral in the market's vault available for payouts
+	Balance(ctx context.Context, market *entities.Market) (uint64, error)
+}
+
+// LockCollateral records collateral deposited into the market's vault
+func LockCollateral(market *entities.Market, amount uint64) error {
+	if market.Collateral > math.MaxUint64-amount {
+		return ErrArithmeticOverflow
+	}
+	market.Collateral += amount
+	return nil
+}
+
+// ReleaseCollateral records collateral paid out of the market's vault
+func ReleaseCollateral(market *entities.Market, amount uint64) error {
+	if amount > market.Collateral {
+		return ErrInsufficientVaultBalance
+	}
+	market.Collateral -= amount
+	return nil
+}
+
+// CheckSolvency rejects a market whose vault holds less than its recorded collateral
+func CheckSolvency(market *entities.Market, vaultBalance uint64) error {
+	if vaultBalance < market.Collateral {
+		return ErrVaultInsolvent
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+// MemoryConfigRepository implements ConfigRepository in memory.
+// It is safe for concurrent use and intended for tests and local runs.
+type MemoryConfigRepository struct {
+	mu     sync.RWMutex
+	config *entities.ProgramConfig
+}
+
+// NewMemoryConfigRepository creates a new MemoryConfigRepository
+func NewMemoryConfigRepository() *MemoryConfigRepository {
+	return &MemoryConfigRepository{}
+}
+
+var _ repositories.ConfigRepository = (*MemoryConfigRepository)(nil)
+
+// Create stores the program config
+func (r *MemoryConfigRepository) Create(ctx context.Context, config *entities.ProgramConfig) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.config != nil {
+		return repositories.ErrAlreadyExists
+	}
+
+	config.Version = 1
+	r.config = cloneConfig(config)
+	return nil
+}
+
+// Get retrieves the program config
+func (r *MemoryConfigRepository) Get(ctx context.Context) (*entities.ProgramConfig, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.config == nil {
+		return nil, repositories.ErrConfigNotFound
+	}
+	return cloneConfig(r.config), nil
+}
+
+// Update stores the modified program config.
+// Returns ErrVersionConflict if the config changed since it was read.
+func (r *MemoryConfigRepository) Update(ctx context.Context, config *entities.ProgramConfig) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.config == nil {
+		return repositories.ErrConfigNotFound
+	}
+	if r.config.Version != config.Version {
+		return repositories.ErrVersionConflict
+	}
+
+	config.Version++
+	r.config = cloneConfig(config)
+	return nil
+}
+
+func cloneConfig(config *entities.ProgramConfig) *entities.ProgramConfig {
+	clone := *config
+	clone.Resolvers = append([]string(nil), config.Resolvers...)
+	clone.Pausers = append([]string(nil), config.Pausers...)
+	return &clone
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+// MemoryLiquidityRepository implements LiquidityRepository in memory.
+// It is safe for concurrent use and intended for tests and local runs.
+type MemoryLiquidityRepository struct {
+	mu        sync.RWMutex
+	positions map[string]*entities.LiquidityPosition
+	order     []string
+}
+
+// NewMemoryLiquidityRepository creates a new MemoryLiquidityRepository
+func NewMemoryLiquidityRepository() *MemoryLiquidityRepository {
+	return &MemoryLiquidityRepository{
+		positions: make(map[string]*entities.LiquidityPosition),
+	}
+}
+
+var _ repositories.LiquidityRepository = (*MemoryLiquidityRepository)(nil)
+
+// Create stores a new liquidity position
+func (r *MemoryLiquidityRepository) Create(ctx context.Context, liquidity *entities.LiquidityPosition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.positions[liquidity.ID]; ok {
+		return repositories.ErrAlreadyExists
+	}
+
+	liquidity.Version = 1
+	r.positions[liquidity.ID] = cloneLiquidity(liquidity)
+	r.order = append(r.order, liquidity.ID)
+	return nil
+}
+
+// GetByMarketAndProvider retrieves the liquidity position of a provider in a market
+func (r *MemoryLiquidityRepository) GetByMarketAndProvider(ctx context.Context, marketID, provider string) (*entities.LiquidityPosition, error) {
+	positions := r.getWhere(func(liquidity *entities.LiquidityPosition) bool {
+		return liquidity.MarketID == marketID && liquidity.Provider == provider
+	})
+	if len(positions) == 0 {
+		return nil, repositories.ErrLiquidityNotFound
+	}
+	return positions[0], nil
+}
+
+// GetByMarketID retrieves all liquidity positions for a market in creation order
+func (r *MemoryLiquidityRepository) GetByMarketID(ctx context.Context, marketID string) ([]*entities.LiquidityPosition, error) {
+	return r.getWhere(func(liquidity *entities.LiquidityPosition) bool {
+		return liquidity.MarketID == marketID
+	}), nil
+}
+
+// Update stores a modified liquidity position.
+// Returns ErrVersionConflict if the position changed since it was read.
+func (r *MemoryLiquidityRepository) Update(ctx context.Context, liquidity *entities.LiquidityPosition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.positions[liquidity.ID]
+	if !ok {
+		return repositories.ErrLiquidityNotFound
+	}
+	if stored.Version != liquidity.Version {
+		return repositories.ErrVersionConflict
+	}
+
+	liquidity.Version++
+	r.positions[liquidity.ID] = cloneLiquidity(liquidity)
+	return nil
+}
+
+func (r *MemoryLiquidityRepository) getWhere(filter func(*entities.LiquidityPosition) bool) []*entities.LiquidityPosition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	positions := make([]*entities.LiquidityPosition, 0)
+	for _, id := range r.order {
+		if liquidity := r.positions[id]; filter(liquidity) {
+			positions = append(positions, cloneLiquidity(liquidity))
+		}
+	}
+	return positions
+}
+
+func cloneLiquidity(liquidity *entities.LiquidityPosition) *entities.LiquidityPosition {
+	clone := *liquidity
+	return &clone
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// MemoryMarketRepository implements MarketRepository in memory.
+// It is safe for concurrent use and intended for tests and local runs.
+type MemoryMarketRepository struct {
+	mu      sync.RWMutex
+	markets map[string]*entities.Market
+	order   []string
+}
+
+// NewMemoryMarketRepository creates a new MemoryMarketRepository
+func NewMemoryMarketRepository() *MemoryMarketRepository {
+	return &MemoryMarketRepository{
+		markets: make(map[string]*entities.Market),
+	}
+}
+
+var _ repositories.MarketRepository = (*MemoryMarketRepository)(nil)
+
+// Create stores a new market
+func (r *MemoryMarketRepository) Create(ctx context.Context, market *entities.Market) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.markets[market.ID]; ok {
+		return repositories.ErrAlreadyExists
+	}
+
+	market.Version = 1
+	r.markets[market.ID] = cloneMarket(market)
+	r.order = append(r.order, market.ID)
+	return nil
+}
+
+// GetByID retrieves a market by ID
+func (r *MemoryMarketRepository) GetByID(ctx context.Context, id string) (*entities.Market, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	market, ok := r.markets[id]
+	if !ok {
+		return nil, services.ErrMarketNotFound
+	}
+	return cloneMarket(market), nil
+}
+
+// Update stores a modified market.
+// Returns ErrVersionConflict if the market changed since it was read.
+func (r *MemoryMarketRepository) Update(ctx context.Context, market *entities.Market) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.markets[market.ID]
+	if !ok {
+		return services.ErrMarketNotFound
+	}
+	if stored.Version != market.Version {
+		return repositories.ErrVersionConflict
+	}
+
+	market.Version++
+	r.markets[market.ID] = cloneMarket(market)
+	return nil
+}
+
+// GetAll retrieves all markets in creation order
+func (r *MemoryMarketRepository) GetAll(ctx context.Context) ([]*entities.Market, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	markets := make([]*entities.Market, 0, len(r.order))
+	for _, id := range r.order {
+		markets = append(markets, cloneMarket(r.markets[id]))
+	}
+	return markets, nil
+}
+
+// GetByCreator retrieves markets by creator in creation order
+func (r *MemoryMarketRepository) GetByCreator(ctx context.Context, creator string) ([]*entities.Market, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	markets := make([]*entities.Market, 0)
+	for _, id := range r.order {
+		if market := r.markets[id]; market.Creator == creator {
+			markets = append(markets, cloneMarket(market))
+		}
+	}
+	return markets, nil
+}
+
+func cloneMarket(market *entities.Market) *entities.Market {
+	clone := *market
+	clone.Outcomes = append([]string(nil), market.Outcomes...)
+	clone.OutcomeShares = append([]uint64(nil), market.OutcomeShares...)
+	if market.Scalar != nil {
+		scalar := *market.Scalar
+		clone.Scalar = &scalar
+	}
+	if market.Oracle != nil {
+		oracle := *market.Oracle
+		clone.Oracle = &oracle
+	}
+	if market.Optimistic != nil {
+		optimistic := *market.Optimistic
+		clone.Optimistic = &optimistic
+	}
+	if market.Proposal != nil {
+		proposal := *market.Proposal
+		clone.Proposal = &proposal
+	}
+	return &clone
+}
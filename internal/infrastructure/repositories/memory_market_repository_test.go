@@ -0,0 +1,213 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+func TestMemoryMarketRepositoryCreate(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryMarketRepository()
+
+	market := &entities.Market{ID: "market-1", Title: "Will it rain?", Creator: testKey(1)}
+	if err := repo.Create(ctx, market); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if market.Version != 1 {
+		t.Fatalf("Create() set Version = %d, want 1", market.Version)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr error
+	}{
+		{"stored", "market-1", "Will it rain?", nil},
+		{"missing", "market-2", "", services.ErrMarketNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.GetByID(ctx, tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.Title != tt.want {
+				t.Fatalf("GetByID() title = %q, want %q", got.Title, tt.want)
+			}
+		})
+	}
+
+	if err := repo.Create(ctx, &entities.Market{ID: "market-1"}); !errors.Is(err, repositories.ErrAlreadyExists) {
+		t.Fatalf("Create() of an existing ID error = %v, want %v", err, repositories.ErrAlreadyExists)
+	}
+}
+
+func TestMemoryMarketRepositoryUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		update  func(repo *MemoryMarketRepository) error
+		wantErr error
+	}{
+		{
+			name: "current version",
+			update: func(repo *MemoryMarketRepository) error {
+				market, _ := repo.GetByID(ctx, "market-1")
+				market.Title = "updated"
+				return repo.Update(ctx, market)
+			},
+		},
+		{
+			name: "stale version",
+			update: func(repo *MemoryMarketRepository) error {
+				stale, _ := repo.GetByID(ctx, "market-1")
+				current, _ := repo.GetByID(ctx, "market-1")
+				current.Title = "updated"
+				if err := repo.Update(ctx, current); err != nil {
+					return err
+				}
+				stale.Title = "lost update"
+				return repo.Update(ctx, stale)
+			},
+			wantErr: repositories.ErrVersionConflict,
+		},
+		{
+			name: "missing",
+			update: func(repo *MemoryMarketRepository) error {
+				return repo.Update(ctx, &entities.Market{ID: "market-2", Version: 1})
+			},
+			wantErr: services.ErrMarketNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMemoryMarketRepository()
+			if err := repo.Create(ctx, &entities.Market{ID: "market-1", Title: "original"}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			if err := tt.update(repo); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, _ := repo.GetByID(ctx, "market-1")
+			if tt.wantErr == nil && stored.Title != "updated" {
+				t.Fatalf("stored title = %q, want %q", stored.Title, "updated")
+			}
+			if tt.wantErr != nil && stored.Title == "lost update" {
+				t.Fatal("rejected update was stored")
+			}
+		})
+	}
+}
+
+func TestMemoryMarketRepositoryReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryMarketRepository()
+
+	market := &entities.Market{ID: "market-1", Title: "original"}
+	if err := repo.Create(ctx, market); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	market.Title = "changed after create"
+
+	got, _ := repo.GetByID(ctx, "market-1")
+	got.Title = "changed after get"
+
+	stored, _ := repo.GetByID(ctx, "market-1")
+	if stored.Title != "original" {
+		t.Fatalf("stored title = %q, want %q", stored.Title, "original")
+	}
+}
+
+func TestMemoryMarketRepositoryQueries(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryMarketRepository()
+	for _, market := range []*entities.Market{
+		{ID: "market-1", Creator: testKey(1)},
+		{ID: "market-2", Creator: testKey(2)},
+		{ID: "market-3", Creator: testKey(1)},
+	} {
+		if err := repo.Create(ctx, market); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query func() ([]*entities.Market, error)
+		want  []string
+	}{
+		{"all in creation order", func() ([]*entities.Market, error) { return repo.GetAll(ctx) }, []string{"market-1", "market-2", "market-3"}},
+		{"by creator", func() ([]*entities.Market, error) { return repo.GetByCreator(ctx, testKey(1)) }, []string{"market-1", "market-3"}},
+		{"unknown creator", func() ([]*entities.Market, error) { return repo.GetByCreator(ctx, testKey(3)) }, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markets, err := tt.query()
+			if err != nil {
+				t.Fatalf("query error = %v", err)
+			}
+			got := make([]string, 0, len(markets))
+			for _, market := range markets {
+				got = append(got, market.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("query = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("query = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryMarketRepositoryConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryMarketRepository()
+	if err := repo.Create(ctx, &entities.Market{ID: "market-1"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Every writer reads the same version, so exactly one update may win
+	const writers = 8
+	markets := make([]*entities.Market, writers)
+	for i := range markets {
+		markets[i], _ = repo.GetByID(ctx, "market-1")
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for _, market := range markets {
+		wg.Add(1)
+		go func(market *entities.Market) {
+			defer wg.Done()
+			errs <- repo.Update(ctx, market)
+		}(market)
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, repositories.ErrVersionConflict):
+			t.Fatalf("Update() error = %v, want nil or %v", err, repositories.ErrVersionConflict)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("%d concurrent updates succeeded, want 1", succeeded)
+	}
+}
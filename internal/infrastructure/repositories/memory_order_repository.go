@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+// MemoryOrderRepository implements OrderRepository in memory.
+// It is safe for concurrent use and intended for tests and local runs.
+type MemoryOrderRepository struct {
+	mu     sync.RWMutex
+	orders map[string]*entities.Order
+	order  []string
+}
+
+// NewMemoryOrderRepository creates a new MemoryOrderRepository
+func NewMemoryOrderRepository() *MemoryOrderRepository {
+	return &MemoryOrderRepository{
+		orders: make(map[string]*entities.Order),
+	}
+}
+
+var _ repositories.OrderRepository = (*MemoryOrderRepository)(nil)
+
+// Create stores a new order
+func (r *MemoryOrderRepository) Create(ctx context.Context, order *entities.Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.orders[order.ID]; ok {
+		return repositories.ErrAlreadyExists
+	}
+
+	order.Version = 1
+	r.orders[order.ID] = cloneOrder(order)
+	r.order = append(r.order, order.ID)
+	return nil
+}
+
+// GetByID retrieves an order by ID
+func (r *MemoryOrderRepository) GetByID(ctx context.Context, id string) (*entities.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order, ok := r.orders[id]
+	if !ok {
+		return nil, repositories.ErrOrderNotFound
+	}
+	return cloneOrder(order), nil
+}
+
+// GetOpenByMarket retrieves the open orders for an outcome of a market in placement order
+func (r *MemoryOrderRepository) GetOpenByMarket(ctx context.Context, marketID string, outcome uint8) ([]*entities.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	orders := make([]*entities.Order, 0)
+	for _, id := range r.order {
+		order := r.orders[id]
+		if order.MarketID == marketID && order.Outcome == outcome && order.IsOpen() {
+			orders = append(orders, cloneOrder(order))
+		}
+	}
+	return orders, nil
+}
+
+// Update stores a modified order.
+// Returns ErrVersionConflict if the order changed since it was read.
+func (r *MemoryOrderRepository) Update(ctx context.Context, order *entities.Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.orders[order.ID]
+	if !ok {
+		return repositories.ErrOrderNotFound
+	}
+	if stored.Version != order.Version {
+		return repositories.ErrVersionConflict
+	}
+
+	order.Version++
+	r.orders[order.ID] = cloneOrder(order)
+	return nil
+}
+
+func cloneOrder(order *entities.Order) *entities.Order {
+	clone := *order
+	return &clone
+}
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+// MemoryPositionRepository implements PositionRepository in memory.
+// It is safe for concurrent use and intended for tests and local runs.
+type MemoryPositionRepository struct {
+	mu        sync.RWMutex
+	positions map[string]*entities.Position
+	order     []string
+}
+
+// NewMemoryPositionRepository creates a new MemoryPositionRepository
+func NewMemoryPositionRepository() *MemoryPositionRepository {
+	return &MemoryPositionRepository{
+		positions: make(map[string]*entities.Position),
+	}
+}
+
+var _ repositories.PositionRepository = (*MemoryPositionRepository)(nil)
+
+// Create stores a new position
+func (r *MemoryPositionRepository) Create(ctx context.Context, position *entities.Position) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.positions[position.ID]; ok {
+		return repositories.ErrAlreadyExists
+	}
+
+	position.Version = 1
+	r.positions[position.ID] = clonePosition(position)
+	r.order = append(r.order, position.ID)
+	return nil
+}
+
+// GetByID retrieves a position by ID
+func (r *MemoryPositionRepository) GetByID(ctx context.Context, id string) (*entities.Position, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	position, ok := r.positions[id]
+	if !ok {
+		return nil, repositories.ErrPositionNotFound
+	}
+	return clonePosition(position), nil
+}
+
+// GetByMarketAndUser retrieves the position of a user in a market
+func (r *MemoryPositionRepository) GetByMarketAndUser(ctx context.Context, marketID, userID string) (*entities.Position, error) {
+	positions := r.getWhere(func(position *entities.Position) bool {
+		return position.MarketID == marketID && position.UserID == userID
+	})
+	if len(positions) == 0 {
+		return nil, repositories.ErrPositionNotFound
+	}
+	return positions[0], nil
+}
+
+// GetByMarketID retrieves all positions for a market in creation order
+func (r *MemoryPositionRepository) GetByMarketID(ctx context.Context, marketID string) ([]*entities.Position, error) {
+	return r.getWhere(func(position *entities.Position) bool {
+		return position.MarketID == marketID
+	}), nil
+}
+
+// GetByUserID retrieves all positions for a user in creation order
+func (r *MemoryPositionRepository) GetByUserID(ctx context.Context, userID string) ([]*entities.Position, error) {
+	return r.getWhere(func(position *entities.Position) bool {
+		return position.UserID == userID
+	}), nil
+}
+
+// Update stores a modified position.
+// Returns ErrVersionConflict if the position changed since it was read.
+func (r *MemoryPositionRepository) Update(ctx context.Context, position *entities.Position) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.positions[position.ID]
+	if !ok {
+		return repositories.ErrPositionNotFound
+	}
+	if stored.Version != position.Version {
+		return repositories.ErrVersionConflict
+	}
+
+	position.Version++
+	r.positions[position.ID] = clonePosition(position)
+	return nil
+}
+
+func (r *MemoryPositionRepository) getWhere(filter func(*entities.Position) bool) []*entities.Position {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	positions := make([]*entities.Position, 0)
+	for _, id := range r.order {
+		if position := r.positions[id]; filter(position) {
+			positions = append(positions, clonePosition(position))
+		}
+	}
+	return positions
+}
+
+func clonePosition(position *entities.Position) *entities.Position {
+	clone := *position
+	return &clone
+}
@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+func TestMemoryPositionRepositoryCreate(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryPositionRepository()
+
+	position := &entities.Position{ID: "position-1", MarketID: "market-1", UserID: testKey(1), Amount: 100}
+	if err := repo.Create(ctx, position); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if position.Version != 1 {
+		t.Fatalf("Create() set Version = %d, want 1", position.Version)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		want    uint64
+		wantErr error
+	}{
+		{"stored", "position-1", 100, nil},
+		{"missing", "position-2", 0, repositories.ErrPositionNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.GetByID(ctx, tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.Amount != tt.want {
+				t.Fatalf("GetByID() amount = %d, want %d", got.Amount, tt.want)
+			}
+		})
+	}
+
+	if err := repo.Create(ctx, &entities.Position{ID: "position-1"}); !errors.Is(err, repositories.ErrAlreadyExists) {
+		t.Fatalf("Create() of an existing ID error = %v, want %v", err, repositories.ErrAlreadyExists)
+	}
+}
+
+func TestMemoryPositionRepositoryUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		update  func(repo *MemoryPositionRepository) error
+		wantErr error
+	}{
+		{
+			name: "current version",
+			update: func(repo *MemoryPositionRepository) error {
+				position, _ := repo.GetByID(ctx, "position-1")
+				position.Amount = 200
+				return repo.Update(ctx, position)
+			},
+		},
+		{
+			name: "stale version",
+			update: func(repo *MemoryPositionRepository) error {
+				stale, _ := repo.GetByID(ctx, "position-1")
+				current, _ := repo.GetByID(ctx, "position-1")
+				current.Amount = 200
+				if err := repo.Update(ctx, current); err != nil {
+					return err
+				}
+				stale.Amount = 999
+				return repo.Update(ctx, stale)
+			},
+			wantErr: repositories.ErrVersionConflict,
+		},
+		{
+			name: "missing",
+			update: func(repo *MemoryPositionRepository) error {
+				return repo.Update(ctx, &entities.Position{ID: "position-2", Version: 1})
+			},
+			wantErr: repositories.ErrPositionNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMemoryPositionRepository()
+			if err := repo.Create(ctx, &entities.Position{ID: "position-1", Amount: 100}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			if err := tt.update(repo); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, _ := repo.GetByID(ctx, "position-1")
+			if tt.wantErr == nil && stored.Amount != 200 {
+				t.Fatalf("stored amount = %d, want 200", stored.Amount)
+			}
+			if stored.Amount == 999 {
+				t.Fatal("rejected update was stored")
+			}
+		})
+	}
+}
+
+func TestMemoryPositionRepositoryQueries(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryPositionRepository()
+	for _, position := range []*entities.Position{
+		{ID: "position-1", MarketID: "market-1", UserID: testKey(1)},
+		{ID: "position-2", MarketID: "market-2", UserID: testKey(1)},
+		{ID: "position-3", MarketID: "market-1", UserID: testKey(2)},
+	} {
+		if err := repo.Create(ctx, position); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query func() ([]*entities.Position, error)
+		want  []string
+	}{
+		{"by market", func() ([]*entities.Position, error) { return repo.GetByMarketID(ctx, "market-1") }, []string{"position-1", "position-3"}},
+		{"by user", func() ([]*entities.Position, error) { return repo.GetByUserID(ctx, testKey(1)) }, []string{"position-1", "position-2"}},
+		{"unknown market", func() ([]*entities.Position, error) { return repo.GetByMarketID(ctx, "market-3") }, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			positions, err := tt.query()
+			if err != nil {
+				t.Fatalf("query error = %v", err)
+			}
+			got := make([]string, 0, len(positions))
+			for _, position := range positions {
+				got = append(got, position.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("query = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("query = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	// Callers get copies, so changing a result does not change the stored position
+	positions, _ := repo.GetByUserID(ctx, testKey(1))
+	positions[0].Amount = 999
+	if stored, _ := repo.GetByID(ctx, "position-1"); stored.Amount != 0 {
+		t.Fatalf("stored amount = %d, want 0", stored.Amount)
+	}
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+)
+
+// MemoryVoteRepository implements VoteRepository in memory.
+// It is safe for concurrent use and intended for tests and local runs.
+type MemoryVoteRepository struct {
+	mu    sync.RWMutex
+	votes map[string]*entities.Vote
+	order []string
+}
+
+// NewMemoryVoteRepository creates a new MemoryVoteRepository
+func NewMemoryVoteRepository() *MemoryVoteRepository {
+	return &MemoryVoteRepository{
+		votes: make(map[string]*entities.Vote),
+	}
+}
+
+var _ repositories.VoteRepository = (*MemoryVoteRepository)(nil)
+
+// Create stores a new vote
+func (r *MemoryVoteRepository) Create(ctx context.Context, vote *entities.Vote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.votes[vote.ID]; ok {
+		return repositories.ErrAlreadyExists
+	}
+
+	vote.Version = 1
+	r.votes[vote.ID] = cloneVote(vote)
+	r.order = append(r.order, vote.ID)
+	return nil
+}
+
+// GetByMarketAndVoter retrieves a committee member's vote on a market
+func (r *MemoryVoteRepository) GetByMarketAndVoter(ctx context.Context, marketID, voter string) (*entities.Vote, error) {
+	votes := r.getWhere(func(vote *entities.Vote) bool {
+		return vote.MarketID == marketID && vote.Voter == voter
+	})
+	if len(votes) == 0 {
+		return nil, repositories.ErrVoteNotFound
+	}
+	return votes[0], nil
+}
+
+// GetByMarketID retrieves all votes cast on a market in the order they were first cast
+func (r *MemoryVoteRepository) GetByMarketID(ctx context.Context, marketID string) ([]*entities.Vote, error) {
+	return r.getWhere(func(vote *entities.Vote) bool {
+		return vote.MarketID == marketID
+	}), nil
+}
+
+// Update stores a modified vote.
+// Returns ErrVersionConflict if the vote changed since it was read.
+func (r *MemoryVoteRepository) Update(ctx context.Context, vote *entities.Vote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.votes[vote.ID]
+	if !ok {
+		return repositories.ErrVoteNotFound
+	}
+	if stored.Version != vote.Version {
+		return repositories.ErrVersionConflict
+	}
+
+	vote.Version++
+	r.votes[vote.ID] = cloneVote(vote)
+	return nil
+}
+
+func (r *MemoryVoteRepository) getWhere(filter func(*entities.Vote) bool) []*entities.Vote {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	votes := make([]*entities.Vote, 0)
+	for _, id := range r.order {
+		if vote := r.votes[id]; filter(vote) {
+			votes = append(votes, cloneVote(vote))
+		}
+	}
+	return votes
+}
+
+func cloneVote(vote *entities.Vote) *entities.Vote {
+	clone := *vote
+	return &clone
+}
@@ -3,18 +3,24 @@ package repositories
 import (
 	"context"
 	"errors"
-	"github.com/gagliardetto/solana-go"
+	"fmt"
+
+	solanago "github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/infrastructure/solana"
 )
 
+// MaxMultipleAccounts is the maximum number of accounts the RPC node returns per getMultipleAccounts call
+const MaxMultipleAccounts = 100
+
 // SolanaAccountRepository handles account operations on Solana
 type SolanaAccountRepository struct {
 	rpcClient      *rpc.Client
 	accountManager *solana.AccountManager
 	serializer     *solana.BorshSerializer
 	validator      *solana.AccountValidator
+	commitment     rpc.CommitmentType
 }
 
 // NewSolanaAccountRepository creates a new SolanaAccountRepository
@@ -29,50 +35,109 @@ func NewSolanaAccountRepository(
 		accountManager: accountManager,
 		serializer:     serializer,
 		validator:      validator,
+		commitment:     rpc.CommitmentConfirmed,
 	}
 }
 
-// GetAccount fetches an account from Solana
-func (r *SolanaAccountRepository) GetAccount(ctx context.Context, publicKey solana.PublicKey) (*entities.Account, error) {
+// GetAccount fetches an account from Solana.
+// Returns solana.ErrAccountNotFound if the account does not exist.
+func (r *SolanaAccountRepository) GetAccount(ctx context.Context, publicKey solanago.PublicKey) (*entities.Account, error) {
 	if r.rpcClient == nil {
 		return nil, errors.New("RPC client not initialized")
 	}
-	
-	// In a real implementation:
-	// accountInfo, err := r.rpcClient.GetAccountInfo(ctx, publicKey)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// 
-	// return &entities.Account{
-	// 	PublicKey:  publicKey,
-	// 	Data:       accountInfo.Value.Data.GetBinary(),
-	// 	Owner:      accountInfo.Value.Owner,
-	// 	Lamports:   accountInfo.Value.Lamports,
-	// 	Executable: accountInfo.Value.Executable,
-	// }, nil
-	
-	// Placeholder
-	return &entities.Account{
-		PublicKey:  publicKey,
-		Data:       []byte{},
-		Owner:      solana.PublicKey{},
-		Lamports:   0,
-		Executable: false,
-	}, nil
+
+	accountInfo, err := r.rpcClient.GetAccountInfoWithOpts(ctx, publicKey, &rpc.GetAccountInfoOpts{
+		Encoding:   solanago.EncodingBase64,
+		Commitment: r.commitment,
+	})
+	if err != nil {
+		if errors.Is(err, rpc.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", solana.ErrAccountNotFound, publicKey)
+		}
+		return nil, err
+	}
+
+	return toAccount(publicKey, accountInfo.Value), nil
+}
+
+// GetMultipleAccounts fetches accounts in batches of MaxMultipleAccounts.
+// The result is aligned with publicKeys; missing accounts are returned as nil.
+func (r *SolanaAccountRepository) GetMultipleAccounts(ctx context.Context, publicKeys []solanago.PublicKey) ([]*entities.Account, error) {
+	if r.rpcClient == nil {
+		return nil, errors.New("RPC client not initialized")
+	}
+
+	accounts := make([]*entities.Account, 0, len(publicKeys))
+	for start := 0; start < len(publicKeys); start += MaxMultipleAccounts {
+		end := start + MaxMultipleAccounts
+		if end > len(publicKeys) {
+			end = len(publicKeys)
+		}
+		batch := publicKeys[start:end]
+
+		result, err := r.rpcClient.GetMultipleAccountsWithOpts(ctx, batch, &rpc.GetMultipleAccountsOpts{
+			Encoding:   solanago.EncodingBase64,
+			Commitment: r.commitment,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Value) != len(batch) {
+			return nil, fmt.Errorf("getMultipleAccounts returned %d accounts, expected %d", len(result.Value), len(batch))
+		}
+
+		for i, value := range result.Value {
+			if value == nil {
+				accounts = append(accounts, nil)
+				continue
+			}
+			accounts = append(accounts, toAccount(batch[i], value))
+		}
+	}
+
+	return accounts, nil
+}
+
+// GetProgramAccountKeys lists the addresses of all program accounts with the given discriminator.
+// Only keys are returned; account data should be loaded with GetMultipleAccounts.
+func (r *SolanaAccountRepository) GetProgramAccountKeys(ctx context.Context, discriminator solana.Discriminator) ([]solanago.PublicKey, error) {
+	if r.rpcClient == nil {
+		return nil, errors.New("RPC client not initialized")
+	}
+
+	var zero uint64
+	result, err := r.rpcClient.GetProgramAccountsWithOpts(ctx, r.accountManager.Program().ID(), &rpc.GetProgramAccountsOpts{
+		Commitment: r.commitment,
+		Filters: []rpc.RPCFilter{
+			{Memcmp: &rpc.RPCFilterMemcmp{Offset: 0, Bytes: solanago.Base58(discriminator[:])}},
+		},
+		DataSlice: &rpc.DataSlice{Offset: &zero, Length: &zero},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]solanago.PublicKey, 0, len(result))
+	for _, keyed := range result {
+		keys = append(keys, keyed.Pubkey)
+	}
+	return keys, nil
 }
 
 // AccountExists checks if an account exists
-func (r *SolanaAccountRepository) AccountExists(ctx context.Context, publicKey solana.PublicKey) (bool, error) {
+func (r *SolanaAccountRepository) AccountExists(ctx context.Context, publicKey solanago.PublicKey) (bool, error) {
 	account, err := r.GetAccount(ctx, publicKey)
 	if err != nil {
+		if errors.Is(err, solana.ErrAccountNotFound) {
+			return false, nil
+		}
 		return false, err
 	}
 	return account != nil && len(account.Data) > 0, nil
 }
 
 // GetAccountData gets account data
-func (r *SolanaAccountRepository) GetAccountData(ctx context.Context, publicKey solana.PublicKey) ([]byte, error) {
+func (r *SolanaAccountRepository) GetAccountData(ctx context.Context, publicKey solanago.PublicKey) ([]byte, error) {
 	account, err := r.GetAccount(ctx, publicKey)
 	if err != nil {
 		return nil, err
@@ -80,3 +145,15 @@ func (r *SolanaAccountRepository) GetAccountData(ctx context.Context, publicKey
 	return account.Data, nil
 }
 
+func toAccount(publicKey solanago.PublicKey, value *rpc.Account) *entities.Account {
+	account := &entities.Account{
+		PublicKey:  publicKey,
+		Owner:      value.Owner,
+		Lamports:   value.Lamports,
+		Executable: value.Executable,
+	}
+	if value.Data != nil {
+		account.Data = value.Data.GetBinary()
+	}
+	return account
+}
@@ -2,8 +2,6 @@ package repositories
 
 import (
 	"context"
-	"github.com/polymarket/solana-program/internal/domain/entities"
-	"github.com/polymarket/solana-program/internal/domain/repositories"
 	"github.com/polymarket/solana-program/internal/infrastructure/solana"
 )
 
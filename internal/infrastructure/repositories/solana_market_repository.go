@@ -2,11 +2,13 @@ package repositories
 
 import (
 	"context"
-	"encoding/binary"
+	"errors"
 	"time"
-	"github.com/gagliardetto/solana-go"
+
+	solanago "github.com/gagliardetto/solana-go"
 	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
 	"github.com/polymarket/solana-program/internal/infrastructure/solana"
 )
 
@@ -45,16 +47,11 @@ func (r *SolanaMarketRepository) Create(ctx context.Context, market *entities.Ma
 	}
 
 	// Serialize market data
-	marketAccount := &entities.MarketAccount{
-		MarketID:   market.ID,
-		Title:      market.Title,
-		EndDate:    market.EndDate.Unix(),
-		Status:     uint8(market.StatusToUint8()),
-		Resolution: uint8(market.ResolutionToUint8()),
+	marketAccount, err := toMarketAccount(market)
+	if err != nil {
+		return err
 	}
 
-	copy(marketAccount.Creator[:], []byte(market.Creator))
-
 	// Serialize using Borsh
 	serializedData, err := r.serializer.SerializeMarketAccount(marketAccount)
 	if err != nil {
@@ -81,48 +78,256 @@ func (r *SolanaMarketRepository) GetByID(ctx context.Context, id string) (*entit
 		return nil, err
 	}
 
-	// Fetch account data
-	accountData, err := r.accountRepo.GetAccountData(ctx, pda)
+	// Fetch account
+	account, err := r.accountRepo.GetAccount(ctx, pda)
 	if err != nil {
+		if errors.Is(err, solana.ErrAccountNotFound) {
+			return nil, services.ErrMarketNotFound
+		}
 		return nil, err
 	}
 
-	// Deserialize market account
-	marketAccount, err := r.serializer.DeserializeMarketAccount(accountData)
+	return r.decodeMarket(account)
+}
+
+// Update updates a market account on Solana
+func (r *SolanaMarketRepository) Update(ctx context.Context, market *entities.Market) error {
+	return r.Create(ctx, market)
+}
+
+// GetAll retrieves all markets owned by the program
+func (r *SolanaMarketRepository) GetAll(ctx context.Context) ([]*entities.Market, error) {
+	keys, err := r.accountRepo.GetProgramAccountKeys(ctx, solana.MarketAccountDiscriminator)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to domain entity
-	market := &entities.Market{
-		ID:          marketAccount.MarketID,
-		Title:       marketAccount.Title,
-		EndDate:     time.Unix(marketAccount.EndDate, 0),
-		Status:      entities.Uint8ToStatus(marketAccount.Status),
-		Resolution:  entities.Uint8ToResolution(marketAccount.Resolution),
-		Creator:       string(marketAccount.Creator[:]),
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+	// Load account data in batches
+	accounts, err := r.accountRepo.GetMultipleAccounts(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	markets := make([]*entities.Market, 0, len(accounts))
+	for _, account := range accounts {
+		if account == nil {
+			// Closed between listing and loading
+			continue
+		}
+		market, err := r.decodeMarket(account)
+		if err != nil {
+			return nil, err
+		}
+		markets = append(markets, market)
 	}
 
-	return market, nil
+	return markets, nil
 }
 
-// Update updates a market account on Solana
-func (r *SolanaMarketRepository) Update(ctx context.Context, market *entities.Market) error {
-	return r.Create(ctx, market)
+// GetByCreator retrieves markets by creator
+func (r *SolanaMarketRepository) GetByCreator(ctx context.Context, creator string) ([]*entities.Market, error) {
+	markets, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.Market, 0)
+	for _, market := range markets {
+		if market.Creator == creator {
+			result = append(result, market)
+		}
+	}
+
+	return result, nil
 }
 
-// GetAll retrieves all markets (would need indexing in production)
-func (r *SolanaMarketRepository) GetAll(ctx context.Context) ([]*entities.Market, error) {
-	// In production, this would require an index or scanning all PDAs
-	return []*entities.Market{}, nil
+// decodeMarket validates and deserializes a market account
+func (r *SolanaMarketRepository) decodeMarket(account *entities.Account) (*entities.Market, error) {
+	if err := r.validator.ValidateProgramAccount(account, solana.MarketAccountDiscriminator, solana.DiscriminatorSize); err != nil {
+		return nil, err
+	}
+
+	marketAccount, err := r.serializer.DeserializeMarketAccount(account.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMarket(marketAccount), nil
 }
 
-// GetByCreator retrieves markets by creator (would need indexing in production)
-func (r *SolanaMarketRepository) GetByCreator(ctx context.Context, creator string) ([]*entities.Market, error) {
-	// In production, this would require an index
-	return []*entities.Market{}, nil
+// toMarketAccount converts a market entity to its on-chain representation
+func toMarketAccount(market *entities.Market) (*entities.MarketAccount, error) {
+	creator, err := solanago.PublicKeyFromBase58(market.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	var collateralMint solanago.PublicKey
+	if !market.IsNativeCollateral() {
+		if collateralMint, err = solanago.PublicKeyFromBase58(market.CollateralMint); err != nil {
+			return nil, err
+		}
+	}
+
+	account := &entities.MarketAccount{
+		MarketID:           market.ID,
+		Title:              market.Title,
+		EndDate:            market.EndDate.Unix(),
+		Status:             market.StatusToUint8(),
+		Resolution:         market.ResolutionToUint8(),
+		ResolvedOutcome:    market.ResolvedOutcome,
+		ResolvedValue:      market.ResolvedValue,
+		Creator:            creator,
+		Liquidity:          market.Liquidity,
+		YesShares:          market.YesShares,
+		NoShares:           market.NoShares,
+		Outcomes:           market.Outcomes,
+		OutcomeShares:      market.OutcomeShares,
+		PricingModel:       market.PricingModelToUint8(),
+		YesReserve:         market.Pool.YesReserve,
+		NoReserve:          market.Pool.NoReserve,
+		LPSupply:           market.Pool.LPSupply,
+		PoolFeeBps:         market.Pool.FeeBps,
+		AccFeePerShare:     market.Pool.AccFeePerShare,
+		PoolFees:           market.Pool.Fees,
+		Collateral:         market.Collateral,
+		CollateralMint:     collateralMint,
+		CollateralDecimals: market.CollateralDecimals,
+		OutcomeTokens:      market.OutcomeTokens,
+		OrderSequence:      market.OrderSequence,
+		ProtocolFeeBps:     market.ProtocolFeeBps,
+		CreatorFeeBps:      market.CreatorFeeBps,
+		ProtocolFees:       market.ProtocolFees,
+		CreatorFees:        market.CreatorFees,
+		Frozen:             market.Frozen,
+	}
+	if market.IsScalar() {
+		account.Scalar = true
+		account.ScalarMin = market.Scalar.Min
+		account.ScalarMax = market.Scalar.Max
+	}
+	if market.IsOracleBacked() {
+		oracleAccount, err := solanago.PublicKeyFromBase58(market.Oracle.Account)
+		if err != nil {
+			return nil, err
+		}
+		account.OracleKind = market.Oracle.Kind.Uint8()
+		account.OracleAccount = oracleAccount
+		account.OracleThreshold = market.Oracle.Threshold
+		account.OracleExponent = market.Oracle.Exponent
+	}
+	if market.IsOptimistic() {
+		arbiter, err := solanago.PublicKeyFromBase58(market.Optimistic.Arbiter)
+		if err != nil {
+			return nil, err
+		}
+		account.Optimistic = true
+		account.ProposalBond = market.Optimistic.Bond
+		account.DisputeWindow = int64(market.Optimistic.DisputeWindow / time.Second)
+		account.Arbiter = arbiter
+	}
+	if proposal := market.Proposal; proposal != nil {
+		proposer, err := solanago.PublicKeyFromBase58(proposal.Proposer)
+		if err != nil {
+			return nil, err
+		}
+		account.HasProposal = true
+		account.Proposer = proposer
+		account.ProposedResolution = proposal.Resolution.Uint8()
+		account.ProposedOutcome = proposal.Outcome
+		account.ProposedValue = proposal.Value
+		account.ProposedAt = proposal.ProposedAt.Unix()
+		if proposal.IsDisputed() {
+			disputer, err := solanago.PublicKeyFromBase58(proposal.Disputer)
+			if err != nil {
+				return nil, err
+			}
+			account.Disputer = disputer
+			account.DisputedAt = proposal.DisputedAt.Unix()
+		}
+	}
+	return account, nil
 }
 
+// toMarket converts an on-chain market account to a market entity
+func toMarket(marketAccount *entities.MarketAccount) *entities.Market {
+	market := &entities.Market{
+		ID:              marketAccount.MarketID,
+		Title:           marketAccount.Title,
+		EndDate:         time.Unix(marketAccount.EndDate, 0),
+		Status:          entities.Uint8ToStatus(marketAccount.Status),
+		Resolution:      entities.Uint8ToResolution(marketAccount.Resolution),
+		ResolvedOutcome: marketAccount.ResolvedOutcome,
+		ResolvedValue:   marketAccount.ResolvedValue,
+		Creator:         solanago.PublicKeyFromBytes(marketAccount.Creator[:]).String(),
+		Liquidity:       marketAccount.Liquidity,
+		YesShares:       marketAccount.YesShares,
+		NoShares:        marketAccount.NoShares,
+		Outcomes:        marketAccount.Outcomes,
+		OutcomeShares:   marketAccount.OutcomeShares,
+		PricingModel:    entities.Uint8ToPricingModel(marketAccount.PricingModel),
+		Pool: entities.Pool{
+			YesReserve:     marketAccount.YesReserve,
+			NoReserve:      marketAccount.NoReserve,
+			LPSupply:       marketAccount.LPSupply,
+			FeeBps:         marketAccount.PoolFeeBps,
+			AccFeePerShare: marketAccount.AccFeePerShare,
+			Fees:           marketAccount.PoolFees,
+		},
+		Collateral:         marketAccount.Collateral,
+		CollateralMint:     collateralMintString(marketAccount.CollateralMint),
+		CollateralDecimals: marketAccount.CollateralDecimals,
+		OutcomeTokens:      marketAccount.OutcomeTokens,
+		OrderSequence:      marketAccount.OrderSequence,
+		ProtocolFeeBps:     marketAccount.ProtocolFeeBps,
+		CreatorFeeBps:      marketAccount.CreatorFeeBps,
+		ProtocolFees:       marketAccount.ProtocolFees,
+		CreatorFees:        marketAccount.CreatorFees,
+		Frozen:             marketAccount.Frozen,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+	if marketAccount.Scalar {
+		market.Scalar = &entities.ScalarRange{
+			Min: marketAccount.ScalarMin,
+			Max: marketAccount.ScalarMax,
+		}
+	}
+	if kind, err := entities.ParseOracleKind(marketAccount.OracleKind); err == nil {
+		market.Oracle = &entities.Oracle{
+			Kind:      kind,
+			Account:   solanago.PublicKeyFromBytes(marketAccount.OracleAccount[:]).String(),
+			Threshold: marketAccount.OracleThreshold,
+			Exponent:  marketAccount.OracleExponent,
+		}
+	}
+	if marketAccount.Optimistic {
+		market.Optimistic = &entities.OptimisticConfig{
+			Bond:          marketAccount.ProposalBond,
+			DisputeWindow: time.Duration(marketAccount.DisputeWindow) * time.Second,
+			Arbiter:       solanago.PublicKeyFromBytes(marketAccount.Arbiter[:]).String(),
+		}
+	}
+	if marketAccount.HasProposal {
+		market.Proposal = &entities.Proposal{
+			Proposer:   solanago.PublicKeyFromBytes(marketAccount.Proposer[:]).String(),
+			Resolution: entities.Uint8ToResolution(marketAccount.ProposedResolution),
+			Outcome:    marketAccount.ProposedOutcome,
+			Value:      marketAccount.ProposedValue,
+			ProposedAt: time.Unix(marketAccount.ProposedAt, 0),
+		}
+		if marketAccount.Disputer != ([32]byte{}) {
+			market.Proposal.Disputer = solanago.PublicKeyFromBytes(marketAccount.Disputer[:]).String()
+			market.Proposal.DisputedAt = time.Unix(marketAccount.DisputedAt, 0)
+		}
+	}
+	return market
+}
 
+// collateralMintString converts an on-chain collateral mint, all zeros for native SOL
+func collateralMintString(mint [32]byte) string {
+	if mint == ([32]byte{}) {
+		return ""
+	}
+	return solanago.PublicKeyFromBytes(mint[:]).String()
+}
@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/infrastructure/solana"
+)
+
+// testKey returns a distinct public key for each seed
+func testKey(seed byte) string {
+	var key [32]byte
+	for i := range key {
+		key[i] = seed + byte(i)
+	}
+	return solanago.PublicKeyFromBytes(key[:]).String()
+}
+
+func TestMarketAccountRoundTrip(t *testing.T) {
+	base := func() *entities.Market {
+		return &entities.Market{
+			ID:                 "market-1",
+			Title:              "Will it rain?",
+			EndDate:            time.Unix(1_767_225_600, 0),
+			Status:             entities.StatusOpen,
+			Resolution:         entities.ResolutionPending,
+			Creator:            testKey(1),
+			Liquidity:          1_000_000,
+			PricingModel:       entities.PricingLMSR,
+			CollateralDecimals: entities.NativeCollateralDecimals,
+			OrderSequence:      4,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(m *entities.Market)
+	}{
+		{"open", func(m *entities.Market) {}},
+		{"traded", func(m *entities.Market) {
+			m.YesShares = 5_000_000
+			m.NoShares = 42
+			m.Collateral = 3_500_000
+		}},
+		{"categorical", func(m *entities.Market) {
+			m.Outcomes = []string{"A", "B", "C"}
+			m.OutcomeShares = []uint64{1, 2, 3}
+			m.Status = entities.StatusResolved
+			m.Resolution = entities.ResolutionOutcome
+			m.ResolvedOutcome = 2
+		}},
+		{"scalar", func(m *entities.Market) {
+			m.Scalar = &entities.ScalarRange{Min: -100, Max: 900}
+			m.Status = entities.StatusResolved
+			m.Resolution = entities.ResolutionScalar
+			m.ResolvedValue = 450
+		}},
+		{"scalar price feed", func(m *entities.Market) {
+			m.Scalar = &entities.ScalarRange{Min: -100, Max: 900}
+			m.Oracle = &entities.Oracle{Kind: entities.OraclePriceFeed, Account: testKey(2), Threshold: 7, Exponent: -8}
+		}},
+		{"constant product with SPL collateral", func(m *entities.Market) {
+			m.Liquidity = 0
+			m.PricingModel = entities.PricingConstantProduct
+			m.Pool = entities.Pool{
+				YesReserve:     4_000_000,
+				NoReserve:      9_000_000,
+				LPSupply:       6_000_000,
+				FeeBps:         200,
+				AccFeePerShare: 17,
+				Fees:           1_234,
+			}
+			m.CollateralMint = testKey(3)
+			m.CollateralDecimals = 6
+			m.OutcomeTokens = true
+		}},
+		{"with fees", func(m *entities.Market) {
+			m.ProtocolFeeBps = 100
+			m.CreatorFeeBps = 50
+			m.ProtocolFees = 12
+			m.CreatorFees = 6
+		}},
+		{"resolved", func(m *entities.Market) {
+			m.Status = entities.StatusResolved
+			m.Resolution = entities.ResolutionNo
+		}},
+		{"cancelled", func(m *entities.Market) {
+			m.Status = entities.StatusCancelled
+			m.Resolution = entities.ResolutionCancelled
+		}},
+		{"frozen", func(m *entities.Market) {
+			m.Frozen = true
+		}},
+		{"optimistic with a disputed proposal", func(m *entities.Market) {
+			m.Status = entities.StatusDisputed
+			m.Optimistic = &entities.OptimisticConfig{Bond: 1_000, DisputeWindow: 2 * time.Hour, Arbiter: testKey(4)}
+			m.Proposal = &entities.Proposal{
+				Proposer:   testKey(5),
+				Resolution: entities.ResolutionYes,
+				ProposedAt: time.Unix(1_767_300_000, 0),
+				Disputer:   testKey(6),
+				DisputedAt: time.Unix(1_767_303_600, 0),
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := base()
+			tt.modify(market)
+
+			account, err := toMarketAccount(market)
+			if err != nil {
+				t.Fatalf("toMarketAccount() error = %v", err)
+			}
+			serializer := solana.NewBorshSerializer()
+			data, err := serializer.SerializeMarketAccount(account)
+			if err != nil {
+				t.Fatalf("SerializeMarketAccount() error = %v", err)
+			}
+			decoded, err := serializer.DeserializeMarketAccount(data)
+			if err != nil {
+				t.Fatalf("DeserializeMarketAccount() error = %v", err)
+			}
+
+			got := toMarket(decoded)
+			// Timestamps of the entity itself are not stored on-chain
+			got.CreatedAt, got.UpdatedAt = market.CreatedAt, market.UpdatedAt
+			if !reflect.DeepEqual(got, market) {
+				t.Fatalf("round trip = %+v, want %+v", got, market)
+			}
+		})
+	}
+}
+
+func TestToMarketAccountRejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		market *entities.Market
+	}{
+		{"creator", &entities.Market{Creator: "not a key"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := toMarketAccount(tt.market); err == nil {
+				t.Fatal("toMarketAccount() succeeded, want an error")
+			}
+		})
+	}
+}
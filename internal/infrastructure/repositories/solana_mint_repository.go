@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/infrastructure/solana"
+)
+
+// SolanaMintRepository implements MintRepository by reading SPL Token mint accounts
+type SolanaMintRepository struct {
+	accountRepo *SolanaAccountRepository
+}
+
+// NewSolanaMintRepository creates a new SolanaMintRepository
+func NewSolanaMintRepository(accountRepo *SolanaAccountRepository) repositories.MintRepository {
+	return &SolanaMintRepository{
+		accountRepo: accountRepo,
+	}
+}
+
+// GetDecimals returns the decimals of an SPL Token mint
+func (r *SolanaMintRepository) GetDecimals(ctx context.Context, mint string) (uint8, error) {
+	key, err := solanago.PublicKeyFromBase58(mint)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", solana.ErrInvalidMint, err)
+	}
+
+	account, err := r.accountRepo.GetAccount(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return solana.DecodeMintDecimals(account)
+}
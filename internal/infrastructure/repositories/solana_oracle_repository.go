@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/infrastructure/solana"
+)
+
+// SolanaOracleRepository implements OracleRepository by reading price update
+// accounts of an external oracle program and committee accounts of this program
+type SolanaOracleRepository struct {
+	serializer  *solana.BorshSerializer
+	validator   *solana.AccountValidator
+	accountRepo *SolanaAccountRepository
+	feedProgram solanago.PublicKey // Program owning the price update accounts, e.g. the Pyth receiver
+}
+
+// NewSolanaOracleRepository creates a new SolanaOracleRepository
+func NewSolanaOracleRepository(
+	serializer *solana.BorshSerializer,
+	validator *solana.AccountValidator,
+	accountRepo *SolanaAccountRepository,
+	feedProgram solanago.PublicKey,
+) repositories.OracleRepository {
+	return &SolanaOracleRepository{
+		serializer:  serializer,
+		validator:   validator,
+		accountRepo: accountRepo,
+		feedProgram: feedProgram,
+	}
+}
+
+// GetPriceFeed reads the price posted in a price update account
+func (r *SolanaOracleRepository) GetPriceFeed(ctx context.Context, account string) (*entities.PriceFeed, error) {
+	key, err := solanago.PublicKeyFromBase58(account)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", solana.ErrInvalidPriceAccount, err)
+	}
+
+	feedAccount, err := r.accountRepo.GetAccount(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return solana.DecodePriceFeed(feedAccount, r.feedProgram)
+}
+
+// GetCommittee reads a committee account
+func (r *SolanaOracleRepository) GetCommittee(ctx context.Context, account string) (*entities.Committee, error) {
+	key, err := solanago.PublicKeyFromBase58(account)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrInvalidCommittee, err)
+	}
+
+	committeeAccount, err := r.accountRepo.GetAccount(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCommittee(r.serializer, r.validator, committeeAccount)
+}
@@ -2,7 +2,10 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
 	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/domain/repositories"
 	"github.com/polymarket/solana-program/internal/infrastructure/solana"
@@ -12,10 +15,10 @@ import (
 type SolanaPositionRepository struct {
 	accountManager *solana.AccountManager
 	program        *solana.Program
-	serializer   *solana.BorshSerializer
-	validator     *solana.AccountValidator
-	accountRepo   *SolanaAccountRepository
-	pdaManager    *solana.PDAManager
+	serializer     *solana.BorshSerializer
+	validator      *solana.AccountValidator
+	accountRepo    *SolanaAccountRepository
+	pdaManager     *solana.PDAManager
 }
 
 // NewSolanaPositionRepository creates a new SolanaPositionRepository
@@ -45,15 +48,11 @@ func (r *SolanaPositionRepository) Create(ctx context.Context, position *entitie
 	}
 
 	// Serialize position data
-	positionAccount := &entities.PositionAccount{
-		MarketID: position.MarketID,
-		Side:     uint8(position.SideToUint8()),
-		Amount:   position.Amount,
-		Price:    position.Price,
+	positionAccount, err := toPositionAccount(position)
+	if err != nil {
+		return err
 	}
 
-	copy(positionAccount.UserID[:], []byte(position.UserID))
-
 	// Serialize using Borsh
 	serializedData, err := r.serializer.SerializePositionAccount(positionAccount)
 	if err != nil {
@@ -81,20 +80,36 @@ func (r *SolanaPositionRepository) GetByID(ctx context.Context, id string) (*ent
 	return nil, nil
 }
 
+// GetByMarketAndUser retrieves the position of a user in a market from its PDA
+func (r *SolanaPositionRepository) GetByMarketAndUser(ctx context.Context, marketID, userID string) (*entities.Position, error) {
+	pda, _, err := r.pdaManager.FindPositionPDA(marketID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := r.accountRepo.GetAccount(ctx, pda)
+	if err != nil {
+		if errors.Is(err, solana.ErrAccountNotFound) {
+			return nil, repositories.ErrPositionNotFound
+		}
+		return nil, err
+	}
+
+	return r.decodePosition(account)
+}
+
 // GetByMarketID retrieves all positions for a market
 func (r *SolanaPositionRepository) GetByMarketID(ctx context.Context, marketID string) ([]*entities.Position, error) {
-	// In production, would use market positions index PDA
-	// For now, return empty
-	_ = marketID
-	return []*entities.Position{}, nil
+	return r.getWhere(ctx, func(position *entities.Position) bool {
+		return position.MarketID == marketID
+	})
 }
 
 // GetByUserID retrieves all positions for a user
 func (r *SolanaPositionRepository) GetByUserID(ctx context.Context, userID string) ([]*entities.Position, error) {
-	// In production, would use user positions index PDA
-	// For now, return empty
-	_ = userID
-	return []*entities.Position{}, nil
+	return r.getWhere(ctx, func(position *entities.Position) bool {
+		return position.UserID == userID
+	})
 }
 
 // Update updates a position account
@@ -102,11 +117,86 @@ func (r *SolanaPositionRepository) Update(ctx context.Context, position *entitie
 	return r.Create(ctx, position)
 }
 
-// Helper method
-func (p *entities.Position) SideToUint8() uint8 {
-	if p.Side == entities.SideYes {
-		return 1
+// getWhere loads all position accounts in batches and keeps those matching filter
+func (r *SolanaPositionRepository) getWhere(ctx context.Context, filter func(*entities.Position) bool) ([]*entities.Position, error) {
+	keys, err := r.accountRepo.GetProgramAccountKeys(ctx, solana.PositionAccountDiscriminator)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := r.accountRepo.GetMultipleAccounts(ctx, keys)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	positions := make([]*entities.Position, 0)
+	for _, account := range accounts {
+		if account == nil {
+			// Closed between listing and loading
+			continue
+		}
+		position, err := r.decodePosition(account)
+		if err != nil {
+			return nil, err
+		}
+		if filter(position) {
+			positions = append(positions, position)
+		}
+	}
+
+	return positions, nil
+}
+
+// decodePosition validates and deserializes a position account
+func (r *SolanaPositionRepository) decodePosition(account *entities.Account) (*entities.Position, error) {
+	if err := r.validator.ValidateProgramAccount(account, solana.PositionAccountDiscriminator, solana.DiscriminatorSize); err != nil {
+		return nil, err
+	}
+
+	positionAccount, err := r.serializer.DeserializePositionAccount(account.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPosition(account.PublicKey, positionAccount), nil
+}
+
+// toPositionAccount converts a position entity to its on-chain representation
+func toPositionAccount(position *entities.Position) (*entities.PositionAccount, error) {
+	user, err := solanago.PublicKeyFromBase58(position.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.PositionAccount{
+		MarketID:    position.MarketID,
+		UserID:      user,
+		Outcome:     position.Outcome,
+		Amount:      position.Amount,
+		Shares:      position.Shares,
+		Price:       position.Price,
+		Locked:      position.Locked,
+		RealizedPnL: position.RealizedPnL,
+		Claimed:     position.Claimed,
+		CostBasis:   position.CostBasis,
+	}, nil
 }
 
+// toPosition converts an on-chain position account to a position entity.
+// The account address is used as the position ID.
+func toPosition(address solanago.PublicKey, positionAccount *entities.PositionAccount) *entities.Position {
+	return &entities.Position{
+		ID:          address.String(),
+		MarketID:    positionAccount.MarketID,
+		UserID:      solanago.PublicKeyFromBytes(positionAccount.UserID[:]).String(),
+		Outcome:     positionAccount.Outcome,
+		Amount:      positionAccount.Amount,
+		Shares:      positionAccount.Shares,
+		Price:       positionAccount.Price,
+		Locked:      positionAccount.Locked,
+		RealizedPnL: positionAccount.RealizedPnL,
+		Claimed:     positionAccount.Claimed,
+		CostBasis:   positionAccount.CostBasis,
+		CreatedAt:   time.Now(),
+	}
+}
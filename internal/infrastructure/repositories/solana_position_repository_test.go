@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"testing"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/infrastructure/solana"
+)
+
+func TestPositionAccountRoundTrip(t *testing.T) {
+	address := solanago.MustPublicKeyFromBase58(testKey(9))
+	tests := []struct {
+		name     string
+		position *entities.Position
+	}{
+		{"yes", &entities.Position{
+			ID:        address.String(),
+			MarketID:  "market-1",
+			UserID:    testKey(1),
+			Outcome:   entities.SideYesValue,
+			Amount:    520,
+			CostBasis: 500,
+			Shares:    1_000,
+			Price:     520_000_000,
+			Locked:    100,
+		}},
+		{"claimed with a loss", &entities.Position{
+			ID:          address.String(),
+			MarketID:    "market-2",
+			UserID:      testKey(2),
+			Outcome:     3,
+			RealizedPnL: -75,
+			Claimed:     true,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := toPositionAccount(tt.position)
+			if err != nil {
+				t.Fatalf("toPositionAccount() error = %v", err)
+			}
+			serializer := solana.NewBorshSerializer()
+			data, err := serializer.SerializePositionAccount(account)
+			if err != nil {
+				t.Fatalf("SerializePositionAccount() error = %v", err)
+			}
+			decoded, err := serializer.DeserializePositionAccount(data)
+			if err != nil {
+				t.Fatalf("DeserializePositionAccount() error = %v", err)
+			}
+
+			got := toPosition(address, decoded)
+			got.CreatedAt = tt.position.CreatedAt
+			if *got != *tt.position {
+				t.Fatalf("round trip = %+v, want %+v", *got, *tt.position)
+			}
+		})
+	}
+}
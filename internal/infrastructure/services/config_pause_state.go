@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ConfigPauseState implements PauseState from the program config account.
+// The protocol is not paused until the config is initialized.
+type ConfigPauseState struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewConfigPauseState creates a new ConfigPauseState
+func NewConfigPauseState(configRepo repositories.ConfigRepository) services.PauseState {
+	return &ConfigPauseState{
+		configRepo: configRepo,
+	}
+}
+
+// IsPaused reports whether the protocol is paused
+func (s *ConfigPauseState) IsPaused(ctx context.Context) (bool, error) {
+	config, err := s.configRepo.Get(ctx)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConfigNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return config.Paused, nil
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/repositories"
+	"github.com/polymarket/solana-program/internal/domain/services"
+)
+
+// ConfigRoles implements Roles from the program config account.
+// No key holds any role until the config is initialized.
+type ConfigRoles struct {
+	configRepo repositories.ConfigRepository
+}
+
+// NewConfigRoles creates a new ConfigRoles
+func NewConfigRoles(configRepo repositories.ConfigRepository) services.Roles {
+	return &ConfigRoles{
+		configRepo: configRepo,
+	}
+}
+
+// Require returns ErrUnauthorized unless key holds role
+func (r *ConfigRoles) Require(ctx context.Context, key string, role entities.Role) error {
+	config, err := r.configRepo.Get(ctx)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConfigNotFound) {
+			return services.ErrUnauthorized
+		}
+		return err
+	}
+	return services.CheckRole(config, key, role)
+}
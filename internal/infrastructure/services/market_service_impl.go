@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"errors"
 	"github.com/polymarket/solana-program/internal/domain/entities"
 	"github.com/polymarket/solana-program/internal/domain/repositories"
 	"github.com/polymarket/solana-program/internal/domain/services"
@@ -31,7 +30,7 @@ func (s *MarketServiceImpl) CreateMarket(ctx context.Context, market *entities.M
 }
 
 // ResolveMarket resolves a market
-func (s *MarketServiceImpl) ResolveMarket(ctx context.Context, marketID string, resolution entities.MarketResolution, resolver string) error {
+func (s *MarketServiceImpl) ResolveMarket(ctx context.Context, marketID string, resolution entities.MarketResolution, outcome uint8, resolver string) error {
 	market, err := s.marketRepo.GetByID(ctx, marketID)
 	if err != nil {
 		return err
@@ -45,13 +44,47 @@ func (s *MarketServiceImpl) ResolveMarket(ctx context.Context, marketID string,
 		return services.ErrInvalidMarketStatus
 	}
 
+	if err := market.ValidateResolution(resolution, outcome); err != nil {
+		return err
+	}
+
 	market.Resolution = resolution
+	market.ResolvedOutcome = outcome
+	market.Status = entities.StatusResolved
+
+	return s.marketRepo.Update(ctx, market)
+}
+
+// ResolveScalar resolves a scalar market to a numeric value.
+// The value is stored as reported and clamped to the market's range at payout.
+func (s *MarketServiceImpl) ResolveScalar(ctx context.Context, marketID string, value int64, resolver string) error {
+	market, err := s.marketRepo.GetByID(ctx, marketID)
+	if err != nil {
+		return err
+	}
+
+	if market == nil {
+		return services.ErrMarketNotFound
+	}
+
+	if !market.IsScalar() {
+		return entities.ErrNotScalarMarket
+	}
+
+	if market.Status != entities.StatusOpen && market.Status != entities.StatusClosed {
+		return services.ErrInvalidMarketStatus
+	}
+
+	market.Resolution = entities.ResolutionScalar
+	market.ResolvedValue = value
 	market.Status = entities.StatusResolved
 
 	return s.marketRepo.Update(ctx, market)
 }
 
-// CloseMarket closes a market
+// CloseMarket closes an open market.
+// Resolved, cancelled and pending-proposal markets cannot be closed, since a
+// closed market can be resolved again.
 func (s *MarketServiceImpl) CloseMarket(ctx context.Context, marketID string) error {
 	market, err := s.marketRepo.GetByID(ctx, marketID)
 	if err != nil {
@@ -62,6 +95,10 @@ func (s *MarketServiceImpl) CloseMarket(ctx context.Context, marketID string) er
 		return services.ErrMarketNotFound
 	}
 
+	if market.Status != entities.StatusOpen {
+		return services.ErrInvalidMarketStatus
+	}
+
 	market.Status = entities.StatusClosed
 	return s.marketRepo.Update(ctx, market)
 }
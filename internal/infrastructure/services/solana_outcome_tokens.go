@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	"github.com/polymarket/solana-program/internal/infrastructure/solana"
+)
+
+// SolanaOutcomeTokens implements OutcomeTokens with one SPL mint per market outcome.
+// The mints are PDAs whose mint authority is the market PDA, so only the
+// program can issue outcome tokens.
+type SolanaOutcomeTokens struct {
+	accountManager     *solana.AccountManager
+	instructionBuilder *solana.InstructionBuilder
+}
+
+// NewSolanaOutcomeTokens creates a new SolanaOutcomeTokens
+func NewSolanaOutcomeTokens(
+	accountManager *solana.AccountManager,
+	instructionBuilder *solana.InstructionBuilder,
+) services.OutcomeTokens {
+	return &SolanaOutcomeTokens{
+		accountManager:     accountManager,
+		instructionBuilder: instructionBuilder,
+	}
+}
+
+// CreateMints initializes a mint for every outcome with the collateral's decimals
+func (t *SolanaOutcomeTokens) CreateMints(ctx context.Context, market *entities.Market) error {
+	authority, _, err := t.accountManager.FindMarketPDA(market.ID)
+	if err != nil {
+		return err
+	}
+
+	for outcome := 0; outcome < market.OutcomeCount(); outcome++ {
+		mint, err := t.findMint(market, uint8(outcome))
+		if err != nil {
+			return err
+		}
+
+		initialize, err := t.instructionBuilder.InitializeMint(mint, authority, market.CollateralDecimals)
+		if err != nil {
+			return err
+		}
+
+		// In a real implementation, the program allocates the mint PDA with
+		// MintAccountSize bytes owned by the token program, then invokes this
+		// instruction (CPI)
+
+		_ = initialize
+	}
+
+	return nil
+}
+
+// Mint issues outcome tokens to owner's associated token account
+func (t *SolanaOutcomeTokens) Mint(ctx context.Context, market *entities.Market, outcome uint8, owner string, amount uint64) error {
+	mint, destination, err := t.findTokenAccount(market, outcome, owner)
+	if err != nil {
+		return err
+	}
+
+	authority, _, err := t.accountManager.FindMarketPDA(market.ID)
+	if err != nil {
+		return err
+	}
+
+	mintTo, err := t.instructionBuilder.MintTo(mint, destination, authority, amount)
+	if err != nil {
+		return err
+	}
+
+	// In a real implementation, the program invokes the token program with
+	// this instruction, signing for the market with its PDA seeds (invoke_signed)
+
+	_ = mintTo
+
+	return nil
+}
+
+// Burn destroys outcome tokens held in owner's associated token account
+func (t *SolanaOutcomeTokens) Burn(ctx context.Context, market *entities.Market, outcome uint8, owner string, amount uint64) error {
+	mint, source, err := t.findTokenAccount(market, outcome, owner)
+	if err != nil {
+		return err
+	}
+
+	ownerKey, err := solanago.PublicKeyFromBase58(owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+
+	burn, err := t.instructionBuilder.Burn(source, mint, ownerKey, amount)
+	if err != nil {
+		return err
+	}
+
+	// In a real implementation, the program invokes the token program with
+	// this instruction; the owner signs the outer transaction
+
+	_ = burn
+
+	return nil
+}
+
+// findMint derives the outcome token mint of outcome
+func (t *SolanaOutcomeTokens) findMint(market *entities.Market, outcome uint8) (solanago.PublicKey, error) {
+	if err := market.ValidateOutcome(outcome); err != nil {
+		return solanago.PublicKey{}, err
+	}
+	mint, _, err := t.accountManager.PDAManager().FindOutcomeMintPDA(market.ID, outcome)
+	return mint, err
+}
+
+// findTokenAccount derives the outcome token mint of outcome and owner's associated token account for it
+func (t *SolanaOutcomeTokens) findTokenAccount(market *entities.Market, outcome uint8, owner string) (solanago.PublicKey, solanago.PublicKey, error) {
+	mint, err := t.findMint(market, outcome)
+	if err != nil {
+		return solanago.PublicKey{}, solanago.PublicKey{}, err
+	}
+
+	ownerKey, err := solanago.PublicKeyFromBase58(owner)
+	if err != nil {
+		return solanago.PublicKey{}, solanago.PublicKey{}, fmt.Errorf("invalid owner: %w", err)
+	}
+
+	tokenAccount, err := solana.FindAssociatedTokenAddress(ownerKey, mint)
+	if err != nil {
+		return solanago.PublicKey{}, solanago.PublicKey{}, err
+	}
+	return mint, tokenAccount, nil
+}
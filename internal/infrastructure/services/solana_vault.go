@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	"github.com/polymarket/solana-program/internal/infrastructure/repositories"
+	"github.com/polymarket/solana-program/internal/infrastructure/solana"
+)
+
+// SolanaVault implements Vault with one program-owned vault PDA per market.
+// Native SOL collateral is held in the vault PDA itself; SPL collateral is
+// held in the vault PDA's associated token account for the market's mint.
+type SolanaVault struct {
+	accountManager     *solana.AccountManager
+	accountRepo        *repositories.SolanaAccountRepository
+	instructionBuilder *solana.InstructionBuilder
+}
+
+// NewSolanaVault creates a new SolanaVault
+func NewSolanaVault(
+	accountManager *solana.AccountManager,
+	accountRepo *repositories.SolanaAccountRepository,
+	instructionBuilder *solana.InstructionBuilder,
+) services.Vault {
+	return &SolanaVault{
+		accountManager:     accountManager,
+		accountRepo:        accountRepo,
+		instructionBuilder: instructionBuilder,
+	}
+}
+
+// Deposit transfers collateral from depositor into the market vault
+func (v *SolanaVault) Deposit(ctx context.Context, market *entities.Market, depositor string, amount uint64) error {
+	from, err := solanago.PublicKeyFromBase58(depositor)
+	if err != nil {
+		return fmt.Errorf("invalid depositor: %w", err)
+	}
+
+	var transfer solanago.Instruction
+	if market.IsNativeCollateral() {
+		vault, _, err := v.accountManager.PDAManager().FindVaultPDA(market.ID)
+		if err != nil {
+			return err
+		}
+		transfer, err = v.instructionBuilder.SystemTransfer(from, vault, amount)
+		if err != nil {
+			return err
+		}
+	} else {
+		mint, err := solanago.PublicKeyFromBase58(market.CollateralMint)
+		if err != nil {
+			return fmt.Errorf("invalid collateral mint: %w", err)
+		}
+		source, err := solana.FindAssociatedTokenAddress(from, mint)
+		if err != nil {
+			return err
+		}
+		destination, err := v.accountManager.FindVaultTokenAccount(market.ID, mint)
+		if err != nil {
+			return err
+		}
+		transfer, err = v.instructionBuilder.TokenTransferChecked(source, mint, destination, from, amount, market.CollateralDecimals)
+		if err != nil {
+			return err
+		}
+	}
+
+	// In a real implementation, the program invokes the system or token
+	// program with this instruction (CPI); the depositor signs the outer transaction
+
+	_ = transfer
+
+	return nil
+}
+
+// Withdraw transfers collateral from the market vault to recipient
+func (v *SolanaVault) Withdraw(ctx context.Context, market *entities.Market, recipient string, amount uint64) error {
+	to, err := solanago.PublicKeyFromBase58(recipient)
+	if err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	available, err := v.Balance(ctx, market)
+	if err != nil {
+		return err
+	}
+	if available < amount {
+		return services.ErrInsufficientVaultBalance
+	}
+
+	if market.IsNativeCollateral() {
+		// In a real implementation, the program debits the vault account it owns
+		// and credits the recipient directly, since a PDA cannot sign a system
+		// transfer out of a program-owned account
+
+		_ = to
+
+		return nil
+	}
+
+	mint, err := solanago.PublicKeyFromBase58(market.CollateralMint)
+	if err != nil {
+		return fmt.Errorf("invalid collateral mint: %w", err)
+	}
+	vault, _, err := v.accountManager.PDAManager().FindVaultPDA(market.ID)
+	if err != nil {
+		return err
+	}
+	source, err := v.accountManager.FindVaultTokenAccount(market.ID, mint)
+	if err != nil {
+		return err
+	}
+	destination, err := solana.FindAssociatedTokenAddress(to, mint)
+	if err != nil {
+		return err
+	}
+
+	transfer, err := v.instructionBuilder.TokenTransferChecked(source, mint, destination, vault, amount, market.CollateralDecimals)
+	if err != nil {
+		return err
+	}
+
+	// In a real implementation, the program invokes the token program with
+	// this instruction, signing for the vault with its PDA seeds (invoke_signed)
+
+	_ = transfer
+
+	return nil
+}
+
+// Balance returns the vault collateral available for payouts.
+// Native vaults keep their rent-exempt minimum so they are never garbage collected.
+func (v *SolanaVault) Balance(ctx context.Context, market *entities.Market) (uint64, error) {
+	if market.IsNativeCollateral() {
+		vault, _, err := v.accountManager.PDAManager().FindVaultPDA(market.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		account, err := v.getAccount(ctx, vault)
+		if err != nil || account == nil {
+			return 0, err
+		}
+
+		reserve := solana.MinimumBalanceForRentExemption(0)
+		if account.Lamports < reserve {
+			return 0, nil
+		}
+		return account.Lamports - reserve, nil
+	}
+
+	mint, err := solanago.PublicKeyFromBase58(market.CollateralMint)
+	if err != nil {
+		return 0, fmt.Errorf("invalid collateral mint: %w", err)
+	}
+	tokenAccount, err := v.accountManager.FindVaultTokenAccount(market.ID, mint)
+	if err != nil {
+		return 0, err
+	}
+
+	account, err := v.getAccount(ctx, tokenAccount)
+	if err != nil || account == nil {
+		return 0, err
+	}
+	return solana.DecodeTokenAmount(account)
+}
+
+// getAccount fetches an account, returning nil if the vault is not funded yet
+func (v *SolanaVault) getAccount(ctx context.Context, address solanago.PublicKey) (*entities.Account, error) {
+	account, err := v.accountRepo.GetAccount(ctx, address)
+	if err != nil {
+		if errors.Is(err, solana.ErrAccountNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return account, nil
+}
@@ -0,0 +1,57 @@
+package solana
+
+import (
+	"github.com/gagliardetto/solana-go"
+)
+
+// AccountManager manages program accounts and their addresses
+type AccountManager struct {
+	program    *Program
+	pdaManager *PDAManager
+}
+
+// NewAccountManager creates a new AccountManager
+func NewAccountManager(program *Program) *AccountManager {
+	return &AccountManager{
+		program:    program,
+		pdaManager: NewPDAManager(program),
+	}
+}
+
+// Program returns the program the accounts belong to
+func (am *AccountManager) Program() *Program {
+	return am.program
+}
+
+// PDAManager returns the PDA manager used for address derivation
+func (am *AccountManager) PDAManager() *PDAManager {
+	return am.pdaManager
+}
+
+// FindMarketPDA derives the market account address
+func (am *AccountManager) FindMarketPDA(marketID string) (solana.PublicKey, uint8, error) {
+	return am.pdaManager.FindMarketPDA(marketID)
+}
+
+// FindPositionPDA derives the position account address
+func (am *AccountManager) FindPositionPDA(marketID, userID string) (solana.PublicKey, uint8, error) {
+	return am.pdaManager.FindPositionPDA(marketID, userID)
+}
+
+// FindVaultTokenAccount derives the token account of a market vault for an SPL collateral mint
+func (am *AccountManager) FindVaultTokenAccount(marketID string, mint solana.PublicKey) (solana.PublicKey, error) {
+	vault, _, err := am.pdaManager.FindVaultPDA(marketID)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	return FindAssociatedTokenAddress(vault, mint)
+}
+
+// FindFeeVaultTokenAccount derives the token account of a market fee vault for an SPL collateral mint
+func (am *AccountManager) FindFeeVaultTokenAccount(marketID string, recipient uint8, mint solana.PublicKey) (solana.PublicKey, error) {
+	vault, _, err := am.pdaManager.FindFeeVaultPDA(marketID, recipient)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	return FindAssociatedTokenAddress(vault, mint)
+}
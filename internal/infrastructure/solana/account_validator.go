@@ -0,0 +1,126 @@
+package solana
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+var (
+	ErrAccountNotFound      = errors.New("account not found")
+	ErrInvalidAccountData   = errors.New("invalid account data")
+	ErrInvalidAccountOwner  = errors.New("account is not owned by the program")
+	ErrAccountNotWritable   = errors.New("account is not writable")
+	ErrMissingSigner        = errors.New("required signer is missing")
+	ErrAccountNotRentExempt = errors.New("account is not rent exempt")
+	ErrAccountExecutable    = errors.New("account is executable")
+)
+
+// AccountValidator validates accounts passed to the program
+type AccountValidator struct {
+	program *Program
+}
+
+// NewAccountValidator creates a new AccountValidator
+func NewAccountValidator(program *Program) *AccountValidator {
+	return &AccountValidator{
+		program: program,
+	}
+}
+
+// ValidateSigner checks that signer is among the transaction signers
+func (v *AccountValidator) ValidateSigner(signer solana.PublicKey, signers []solana.PublicKey) error {
+	if signer.IsZero() {
+		return ErrMissingSigner
+	}
+	for _, s := range signers {
+		if s.Equals(signer) {
+			return nil
+		}
+	}
+	return ErrMissingSigner
+}
+
+// ValidateWritable checks that the account meta for key is marked writable
+func (v *AccountValidator) ValidateWritable(key solana.PublicKey, accounts []*solana.AccountMeta) error {
+	for _, acc := range accounts {
+		if acc != nil && acc.PublicKey.Equals(key) {
+			if !acc.IsWritable {
+				return ErrAccountNotWritable
+			}
+			return nil
+		}
+	}
+	return ErrAccountNotFound
+}
+
+// ValidateExists checks that the account has been created
+func (v *AccountValidator) ValidateExists(account *entities.Account) error {
+	if account == nil || (account.Lamports == 0 && len(account.Data) == 0) {
+		return ErrAccountNotFound
+	}
+	return nil
+}
+
+// ValidateOwner checks that the account is owned by the program
+func (v *AccountValidator) ValidateOwner(account *entities.Account) error {
+	if err := v.ValidateExists(account); err != nil {
+		return err
+	}
+	if !v.program.IsOwnerOf(account.Owner) {
+		return ErrInvalidAccountOwner
+	}
+	if account.Executable {
+		return ErrAccountExecutable
+	}
+	return nil
+}
+
+// ValidateDataLength checks that the account holds at least minLength bytes
+func (v *AccountValidator) ValidateDataLength(account *entities.Account, minLength int) error {
+	if err := v.ValidateExists(account); err != nil {
+		return err
+	}
+	if len(account.Data) < minLength {
+		return ErrAccountDataTooShort
+	}
+	return nil
+}
+
+// ValidateDiscriminator checks the account kind stored in the first 8 bytes
+func (v *AccountValidator) ValidateDiscriminator(account *entities.Account, discriminator Discriminator) error {
+	if err := v.ValidateDataLength(account, DiscriminatorSize); err != nil {
+		return err
+	}
+	if !bytes.Equal(account.Data[:DiscriminatorSize], discriminator[:]) {
+		return ErrDiscriminatorMismatch
+	}
+	return nil
+}
+
+// ValidateRentExempt checks that the account balance covers rent exemption for its data
+func (v *AccountValidator) ValidateRentExempt(account *entities.Account) error {
+	if err := v.ValidateExists(account); err != nil {
+		return err
+	}
+	if account.Lamports < MinimumBalanceForRentExemption(uint64(len(account.Data))) {
+		return ErrAccountNotRentExempt
+	}
+	return nil
+}
+
+// ValidateProgramAccount runs all checks required before reading a program account
+func (v *AccountValidator) ValidateProgramAccount(account *entities.Account, discriminator Discriminator, minLength int) error {
+	if err := v.ValidateOwner(account); err != nil {
+		return err
+	}
+	if err := v.ValidateDataLength(account, minLength); err != nil {
+		return err
+	}
+	if err := v.ValidateDiscriminator(account, discriminator); err != nil {
+		return err
+	}
+	return v.ValidateRentExempt(account)
+}
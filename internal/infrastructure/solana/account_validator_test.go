@@ -0,0 +1,116 @@
+package solana
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+func TestAccountValidatorValidateSigner(t *testing.T) {
+	v := NewAccountValidator(NewProgram(solana.SystemProgramID))
+	signer := solana.NewWallet().PublicKey()
+	other := solana.NewWallet().PublicKey()
+
+	tests := []struct {
+		name    string
+		signer  solana.PublicKey
+		signers []solana.PublicKey
+		wantErr error
+	}{
+		{"signed", signer, []solana.PublicKey{other, signer}, nil},
+		{"not signed", signer, []solana.PublicKey{other}, ErrMissingSigner},
+		{"no signers", signer, nil, ErrMissingSigner},
+		{"zero key", solana.PublicKey{}, []solana.PublicKey{{}}, ErrMissingSigner},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.ValidateSigner(tt.signer, tt.signers); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateSigner() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccountValidatorValidateWritable(t *testing.T) {
+	v := NewAccountValidator(NewProgram(solana.SystemProgramID))
+	writable := solana.NewWallet().PublicKey()
+	readonly := solana.NewWallet().PublicKey()
+	accounts := []*solana.AccountMeta{
+		solana.Meta(writable).WRITE(),
+		solana.Meta(readonly),
+	}
+
+	tests := []struct {
+		name    string
+		key     solana.PublicKey
+		wantErr error
+	}{
+		{"writable", writable, nil},
+		{"read only", readonly, ErrAccountNotWritable},
+		{"missing", solana.NewWallet().PublicKey(), ErrAccountNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.ValidateWritable(tt.key, accounts); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateWritable() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccountValidatorValidateProgramAccount(t *testing.T) {
+	program := NewProgram(solana.NewWallet().PublicKey())
+	v := NewAccountValidator(program)
+
+	data, err := NewBorshSerializer().SerializeMarketAccount(&entities.MarketAccount{MarketID: "market"})
+	if err != nil {
+		t.Fatalf("SerializeMarketAccount() error = %v", err)
+	}
+	rentExempt := MinimumBalanceForRentExemption(uint64(len(data)))
+
+	valid := func() *entities.Account {
+		return &entities.Account{Data: data, Owner: program.ID(), Lamports: rentExempt}
+	}
+
+	tests := []struct {
+		name      string
+		account   func() *entities.Account
+		minLength int
+		wantErr   error
+	}{
+		{"valid", valid, DiscriminatorSize, nil},
+		{"missing", func() *entities.Account { return nil }, DiscriminatorSize, ErrAccountNotFound},
+		{"empty", func() *entities.Account { return &entities.Account{} }, DiscriminatorSize, ErrAccountNotFound},
+		{
+			"other owner",
+			func() *entities.Account { a := valid(); a.Owner = solana.SystemProgramID; return a },
+			DiscriminatorSize, ErrInvalidAccountOwner,
+		},
+		{
+			"executable",
+			func() *entities.Account { a := valid(); a.Executable = true; return a },
+			DiscriminatorSize, ErrAccountExecutable,
+		},
+		{"too short", valid, len(data) + 1, ErrAccountDataTooShort},
+		{
+			"other account kind",
+			func() *entities.Account { a := valid(); a.Data = append([]byte{}, data...); a.Data[0]++; return a },
+			DiscriminatorSize, ErrDiscriminatorMismatch,
+		},
+		{
+			"below rent exemption",
+			func() *entities.Account { a := valid(); a.Lamports = rentExempt - 1; return a },
+			DiscriminatorSize, ErrAccountNotRentExempt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateProgramAccount(tt.account(), MarketAccountDiscriminator, tt.minLength)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateProgramAccount() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/near/borsh-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// DiscriminatorSize is the number of bytes prefixed to every program account
+const DiscriminatorSize = 8
+
+// Discriminator identifies the kind of a program account
+type Discriminator [DiscriminatorSize]byte
+
+// Account discriminators, computed as sha256("account:<Name>")[:8] so they
+// match the layout used by Anchor-based Rust programs
+var (
+	MarketAccountDiscriminator    = NewDiscriminator("MarketAccount")
+	PositionAccountDiscriminator  = NewDiscriminator("PositionAccount")
+	LiquidityAccountDiscriminator = NewDiscriminator("LiquidityAccount")
+	OrderAccountDiscriminator     = NewDiscriminator("OrderAccount")
+	CommitteeAccountDiscriminator = NewDiscriminator("CommitteeAccount")
+	VoteAccountDiscriminator      = NewDiscriminator("VoteAccount")
+	ConfigAccountDiscriminator    = NewDiscriminator("ConfigAccount")
+)
+
+var (
+	ErrAccountDataTooShort   = errors.New("account data too short")
+	ErrDiscriminatorMismatch = errors.New("account discriminator mismatch")
+)
+
+// NewDiscriminator computes the discriminator for an account type name
+func NewDiscriminator(name string) Discriminator {
+	var d Discriminator
+	hash := sha256.Sum256([]byte("account:" + name))
+	copy(d[:], hash[:DiscriminatorSize])
+	return d
+}
+
+// ReadDiscriminator returns the discriminator stored at the start of account data
+func ReadDiscriminator(data []byte) (Discriminator, error) {
+	var d Discriminator
+	if len(data) < DiscriminatorSize {
+		return d, ErrAccountDataTooShort
+	}
+	copy(d[:], data[:DiscriminatorSize])
+	return d, nil
+}
+
+// BorshSerializer encodes and decodes program accounts in Borsh format.
+// Strings are u32 length-prefixed, integers are little-endian and public
+// keys are fixed 32-byte arrays, matching the Rust borsh crate.
+type BorshSerializer struct{}
+
+// NewBorshSerializer creates a new BorshSerializer
+func NewBorshSerializer() *BorshSerializer {
+	return &BorshSerializer{}
+}
+
+// Serialize encodes value with the given discriminator prefix
+func (s *BorshSerializer) Serialize(discriminator Discriminator, value interface{}) ([]byte, error) {
+	body, err := borsh.Serialize(value)
+	if err != nil {
+		return nil, fmt.Errorf("borsh serialize: %w", err)
+	}
+
+	data := make([]byte, 0, DiscriminatorSize+len(body))
+	data = append(data, discriminator[:]...)
+	return append(data, body...), nil
+}
+
+// Deserialize checks the discriminator prefix and decodes the rest of data into value.
+// Trailing bytes are ignored, since accounts are usually allocated larger than their contents.
+func (s *BorshSerializer) Deserialize(discriminator Discriminator, data []byte, value interface{}) error {
+	actual, err := ReadDiscriminator(data)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(actual[:], discriminator[:]) {
+		return ErrDiscriminatorMismatch
+	}
+
+	if err := borsh.Deserialize(value, data[DiscriminatorSize:]); err != nil {
+		return fmt.Errorf("borsh deserialize: %w", err)
+	}
+	return nil
+}
+
+// SerializeMarketAccount serializes a MarketAccount
+func (s *BorshSerializer) SerializeMarketAccount(account *entities.MarketAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("market account is nil")
+	}
+	return s.Serialize(MarketAccountDiscriminator, *account)
+}
+
+// DeserializeMarketAccount deserializes a MarketAccount
+func (s *BorshSerializer) DeserializeMarketAccount(data []byte) (*entities.MarketAccount, error) {
+	account := &entities.MarketAccount{}
+	if err := s.Deserialize(MarketAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// SerializePositionAccount serializes a PositionAccount
+func (s *BorshSerializer) SerializePositionAccount(account *entities.PositionAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("position account is nil")
+	}
+	return s.Serialize(PositionAccountDiscriminator, *account)
+}
+
+// DeserializePositionAccount deserializes a PositionAccount
+func (s *BorshSerializer) DeserializePositionAccount(data []byte) (*entities.PositionAccount, error) {
+	account := &entities.PositionAccount{}
+	if err := s.Deserialize(PositionAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// SerializeLiquidityAccount serializes a LiquidityAccount
+func (s *BorshSerializer) SerializeLiquidityAccount(account *entities.LiquidityAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("liquidity account is nil")
+	}
+	return s.Serialize(LiquidityAccountDiscriminator, *account)
+}
+
+// DeserializeLiquidityAccount deserializes a LiquidityAccount
+func (s *BorshSerializer) DeserializeLiquidityAccount(data []byte) (*entities.LiquidityAccount, error) {
+	account := &entities.LiquidityAccount{}
+	if err := s.Deserialize(LiquidityAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// SerializeOrderAccount serializes an OrderAccount
+func (s *BorshSerializer) SerializeOrderAccount(account *entities.OrderAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("order account is nil")
+	}
+	return s.Serialize(OrderAccountDiscriminator, *account)
+}
+
+// DeserializeOrderAccount deserializes an OrderAccount
+func (s *BorshSerializer) DeserializeOrderAccount(data []byte) (*entities.OrderAccount, error) {
+	account := &entities.OrderAccount{}
+	if err := s.Deserialize(OrderAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// SerializeCommitteeAccount serializes a CommitteeAccount
+func (s *BorshSerializer) SerializeCommitteeAccount(account *entities.CommitteeAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("committee account is nil")
+	}
+	return s.Serialize(CommitteeAccountDiscriminator, *account)
+}
+
+// DeserializeCommitteeAccount deserializes a CommitteeAccount
+func (s *BorshSerializer) DeserializeCommitteeAccount(data []byte) (*entities.CommitteeAccount, error) {
+	account := &entities.CommitteeAccount{}
+	if err := s.Deserialize(CommitteeAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// SerializeVoteAccount serializes a VoteAccount
+func (s *BorshSerializer) SerializeVoteAccount(account *entities.VoteAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("vote account is nil")
+	}
+	return s.Serialize(VoteAccountDiscriminator, *account)
+}
+
+// DeserializeVoteAccount deserializes a VoteAccount
+func (s *BorshSerializer) DeserializeVoteAccount(data []byte) (*entities.VoteAccount, error) {
+	account := &entities.VoteAccount{}
+	if err := s.Deserialize(VoteAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// SerializeConfigAccount serializes a ConfigAccount
+func (s *BorshSerializer) SerializeConfigAccount(account *entities.ConfigAccount) ([]byte, error) {
+	if account == nil {
+		return nil, errors.New("config account is nil")
+	}
+	return s.Serialize(ConfigAccountDiscriminator, *account)
+}
+
+// DeserializeConfigAccount deserializes a ConfigAccount
+func (s *BorshSerializer) DeserializeConfigAccount(data []byte) (*entities.ConfigAccount, error) {
+	account := &entities.ConfigAccount{}
+	if err := s.Deserialize(ConfigAccountDiscriminator, data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
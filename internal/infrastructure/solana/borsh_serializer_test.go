@@ -0,0 +1,164 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// fill sets every field of v to a distinct non-zero value derived from seed
+func fill(v reflect.Value, seed int) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fill(v.Field(i), seed*31+i+1)
+		}
+	case reflect.String:
+		v.SetString(fmt.Sprintf("field-%d", seed))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(seed%250 + 1))
+	case reflect.Int32, reflect.Int64:
+		v.SetInt(-int64(seed))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			v.Index(i).SetUint(uint64(seed + i))
+		}
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 3, 3)
+		for i := 0; i < s.Len(); i++ {
+			fill(s.Index(i), seed+i)
+		}
+		v.Set(s)
+	}
+}
+
+func TestBorshSerializerRoundTrip(t *testing.T) {
+	s := NewBorshSerializer()
+	tests := []struct {
+		name        string
+		account     interface{}
+		serialize   func(interface{}) ([]byte, error)
+		deserialize func([]byte) (interface{}, error)
+	}{
+		{
+			name:        "market",
+			account:     &entities.MarketAccount{},
+			serialize:   func(a interface{}) ([]byte, error) { return s.SerializeMarketAccount(a.(*entities.MarketAccount)) },
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializeMarketAccount(data) },
+		},
+		{
+			name:        "position",
+			account:     &entities.PositionAccount{},
+			serialize:   func(a interface{}) ([]byte, error) { return s.SerializePositionAccount(a.(*entities.PositionAccount)) },
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializePositionAccount(data) },
+		},
+		{
+			name:    "liquidity",
+			account: &entities.LiquidityAccount{},
+			serialize: func(a interface{}) ([]byte, error) {
+				return s.SerializeLiquidityAccount(a.(*entities.LiquidityAccount))
+			},
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializeLiquidityAccount(data) },
+		},
+		{
+			name:        "order",
+			account:     &entities.OrderAccount{},
+			serialize:   func(a interface{}) ([]byte, error) { return s.SerializeOrderAccount(a.(*entities.OrderAccount)) },
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializeOrderAccount(data) },
+		},
+		{
+			name:    "committee",
+			account: &entities.CommitteeAccount{},
+			serialize: func(a interface{}) ([]byte, error) {
+				return s.SerializeCommitteeAccount(a.(*entities.CommitteeAccount))
+			},
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializeCommitteeAccount(data) },
+		},
+		{
+			name:        "vote",
+			account:     &entities.VoteAccount{},
+			serialize:   func(a interface{}) ([]byte, error) { return s.SerializeVoteAccount(a.(*entities.VoteAccount)) },
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializeVoteAccount(data) },
+		},
+		{
+			name:        "config",
+			account:     &entities.ConfigAccount{},
+			serialize:   func(a interface{}) ([]byte, error) { return s.SerializeConfigAccount(a.(*entities.ConfigAccount)) },
+			deserialize: func(data []byte) (interface{}, error) { return s.DeserializeConfigAccount(data) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fill(reflect.ValueOf(tt.account).Elem(), 1)
+
+			data, err := tt.serialize(tt.account)
+			if err != nil {
+				t.Fatalf("serialize error = %v", err)
+			}
+			got, err := tt.deserialize(data)
+			if err != nil {
+				t.Fatalf("deserialize error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.account) {
+				t.Fatalf("round trip = %+v, want %+v", got, tt.account)
+			}
+
+			// Accounts are allocated larger than their contents
+			padded, err := tt.deserialize(append(data, make([]byte, 64)...))
+			if err != nil {
+				t.Fatalf("deserialize of padded data error = %v", err)
+			}
+			if !reflect.DeepEqual(padded, tt.account) {
+				t.Fatalf("padded round trip = %+v, want %+v", padded, tt.account)
+			}
+		})
+	}
+}
+
+func TestBorshSerializerRejectsOtherAccounts(t *testing.T) {
+	s := NewBorshSerializer()
+	position, err := s.SerializePositionAccount(&entities.PositionAccount{MarketID: "market"})
+	if err != nil {
+		t.Fatalf("SerializePositionAccount() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"other account kind", position, ErrDiscriminatorMismatch},
+		{"shorter than the discriminator", MarketAccountDiscriminator[:4], ErrAccountDataTooShort},
+		{"empty", nil, ErrAccountDataTooShort},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.DeserializeMarketAccount(tt.data); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeserializeMarketAccount() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiscriminatorsAreDistinct(t *testing.T) {
+	seen := make(map[Discriminator]bool)
+	for _, d := range []Discriminator{
+		MarketAccountDiscriminator,
+		PositionAccountDiscriminator,
+		LiquidityAccountDiscriminator,
+		OrderAccountDiscriminator,
+		CommitteeAccountDiscriminator,
+		VoteAccountDiscriminator,
+		ConfigAccountDiscriminator,
+	} {
+		if seen[d] {
+			t.Fatalf("discriminator %x is used twice", d)
+		}
+		seen[d] = true
+	}
+}
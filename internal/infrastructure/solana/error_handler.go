@@ -1,12 +1,18 @@
 package solana
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 )
 
+// RPCErrCodeInvalidParams is returned by the RPC node for invalid or missing accounts
+const RPCErrCodeInvalidParams = -32602
+
 // ErrorHandler handles Solana-specific errors
 type ErrorHandler struct{}
 
@@ -42,27 +48,26 @@ func (eh *ErrorHandler) HandleWalletConnecttionError(signature solana.Wallet, er
 		return nil
 	}
 
-	return fmt.Errorf("transaction %s failed: %w", signature.String(), err)
+	return fmt.Errorf("transaction %s failed: %w", signature.PublicKey().String(), err)
 }
 
 // IsAccountNotFoundError checks if error is account not found
 func (eh *ErrorHandler) IsAccountNotFoundError(err error) bool {
-	// In a real implementation, check RPC error code
-	return err != nil
+	return errors.Is(err, ErrAccountNotFound) || errors.Is(err, rpc.ErrNotFound)
 }
 
 // ParseRPCError parses RPC error response
-func (eh *ErrorHandler) ParseRPCError(rpcErr *rpc.RPCError) error {
+func (eh *ErrorHandler) ParseRPCError(rpcErr *jsonrpc.RPCError) error {
 	if rpcErr == nil {
 		return nil
 	}
 
 	// Map RPC error codes to domain errors
-	switch rpcErr.Code {
-	case rpc.ErrCodeAccountNotFound:
+	switch {
+	case rpcErr.Code == RPCErrCodeInvalidParams && strings.Contains(rpcErr.Message, "could not find account"):
 		return ErrAccountNotFound
-	case rpc.ErrCodeInvalidAccountData:
-		return ErrInvalidAccountData
+	case rpcErr.Code == RPCErrCodeInvalidParams:
+		return fmt.Errorf("%w: %s", ErrInvalidAccountData, rpcErr.Message)
 	default:
 		return fmt.Errorf("RPC error %d: %s", rpcErr.Code, rpcErr.Message)
 	}
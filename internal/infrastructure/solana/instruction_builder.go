@@ -0,0 +1,67 @@
+package solana
+
+import (
+	"github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+)
+
+// InstructionBuilder builds instructions for this program and the
+// cross-program invocations (CPIs) it makes
+type InstructionBuilder struct {
+	programID solana.PublicKey
+}
+
+// NewInstructionBuilder creates a new InstructionBuilder
+func NewInstructionBuilder(programID solana.PublicKey) *InstructionBuilder {
+	return &InstructionBuilder{
+		programID: programID,
+	}
+}
+
+// Build creates an instruction for this program from encoded instruction data
+func (b *InstructionBuilder) Build(data []byte, accounts []*solana.AccountMeta) solana.Instruction {
+	return solana.NewInstruction(b.programID, accounts, data)
+}
+
+// SystemTransfer builds a system program transfer of lamports.
+// from must sign the transaction.
+func (b *InstructionBuilder) SystemTransfer(from, to solana.PublicKey, lamports uint64) (solana.Instruction, error) {
+	return system.NewTransferInstruction(lamports, from, to).ValidateAndBuild()
+}
+
+// TokenTransfer builds an SPL token program transfer between token accounts.
+// owner must sign the transaction, or be the PDA signing through invoke_signed.
+func (b *InstructionBuilder) TokenTransfer(source, destination, owner solana.PublicKey, amount uint64) (solana.Instruction, error) {
+	return token.NewTransferInstruction(amount, source, destination, owner, nil).ValidateAndBuild()
+}
+
+// TokenTransferChecked builds an SPL token transfer that also checks the mint and its decimals
+func (b *InstructionBuilder) TokenTransferChecked(source, mint, destination, owner solana.PublicKey, amount uint64, decimals uint8) (solana.Instruction, error) {
+	return token.NewTransferCheckedInstruction(amount, decimals, source, mint, destination, owner, nil).ValidateAndBuild()
+}
+
+// CreateAssociatedTokenAccount builds the creation of owner's associated token account for mint
+func (b *InstructionBuilder) CreateAssociatedTokenAccount(payer, owner, mint solana.PublicKey) (solana.Instruction, error) {
+	return associatedtokenaccount.NewCreateInstruction(payer, owner, mint).ValidateAndBuild()
+}
+
+// InitializeMint builds the initialization of an SPL token mint without a freeze authority
+func (b *InstructionBuilder) InitializeMint(mint, mintAuthority solana.PublicKey, decimals uint8) (solana.Instruction, error) {
+	return token.NewInitializeMint2InstructionBuilder().
+		SetDecimals(decimals).
+		SetMintAuthority(mintAuthority).
+		SetMintAccount(mint).
+		ValidateAndBuild()
+}
+
+// MintTo builds an SPL token mint to destination, signed by the mint authority
+func (b *InstructionBuilder) MintTo(mint, destination, authority solana.PublicKey, amount uint64) (solana.Instruction, error) {
+	return token.NewMintToInstruction(amount, mint, destination, authority, nil).ValidateAndBuild()
+}
+
+// Burn builds an SPL token burn from source, signed by its owner
+func (b *InstructionBuilder) Burn(source, mint, owner solana.PublicKey, amount uint64) (solana.Instruction, error) {
+	return token.NewBurnInstruction(amount, source, mint, owner, nil).ValidateAndBuild()
+}
@@ -0,0 +1,293 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// PDA seed prefixes. Every address is derived with FindProgramAddress
+// using the prefix followed by the listed seeds:
+//
+//	market:                   ["market", market_id]
+//	position:                 ["position", market_id, user_pubkey]
+//	market index:             ["market_index"]
+//	user position index:      ["user_positions", user_pubkey]
+//	market position index:    ["market_positions", market_id]
+//	liquidity:                ["liquidity", market_id, provider_pubkey]
+//	market vault:             ["vault", market_id]
+//	outcome token mint:       ["outcome_mint", market_id, outcome]
+//	order:                    ["order", order_id]
+//	fee vault:                ["fee_vault", market_id, recipient]
+//	committee vote:           ["vote", market_id, voter_pubkey]
+//	program config:           ["config"]
+const (
+	MarketSeed              = "market"
+	PositionSeed            = "position"
+	MarketIndexSeed         = "market_index"
+	UserPositionIndexSeed   = "user_positions"
+	MarketPositionIndexSeed = "market_positions"
+	LiquiditySeed           = "liquidity"
+	VaultSeed               = "vault"
+	OutcomeMintSeed         = "outcome_mint"
+	OrderSeed               = "order"
+	FeeVaultSeed            = "fee_vault"
+	VoteSeed                = "vote"
+	ConfigSeed              = "config"
+)
+
+var (
+	ErrInvalidPDA      = errors.New("account does not match program derived address")
+	ErrSeedTooLong     = errors.New("pda seed exceeds 32 bytes")
+	ErrInvalidSeedUser = errors.New("invalid user public key seed")
+)
+
+// derivedAddress is a cached PDA with its bump seed
+type derivedAddress struct {
+	address solana.PublicKey
+	bump    uint8
+}
+
+// PDAManager derives Program Derived Addresses for all program accounts
+type PDAManager struct {
+	program *Program
+	mu      sync.RWMutex
+	cache   map[string]derivedAddress
+}
+
+// NewPDAManager creates a new PDAManager
+func NewPDAManager(program *Program) *PDAManager {
+	return &PDAManager{
+		program: program,
+		cache:   make(map[string]derivedAddress),
+	}
+}
+
+// FindMarketPDA derives the market account address
+func (m *PDAManager) FindMarketPDA(marketID string) (solana.PublicKey, uint8, error) {
+	return m.FindPDA(MarketSeeds(marketID))
+}
+
+// FindPositionPDA derives the position account address for a user in a market
+func (m *PDAManager) FindPositionPDA(marketID, userID string) (solana.PublicKey, uint8, error) {
+	seeds, err := PositionSeeds(marketID, userID)
+	if err != nil {
+		return solana.PublicKey{}, 0, err
+	}
+	return m.FindPDA(seeds)
+}
+
+// FindLiquidityPDA derives the liquidity position address for a provider in a market
+func (m *PDAManager) FindLiquidityPDA(marketID, provider string) (solana.PublicKey, uint8, error) {
+	seeds, err := LiquiditySeeds(marketID, provider)
+	if err != nil {
+		return solana.PublicKey{}, 0, err
+	}
+	return m.FindPDA(seeds)
+}
+
+// FindVaultPDA derives the address of the vault holding a market's collateral
+func (m *PDAManager) FindVaultPDA(marketID string) (solana.PublicKey, uint8, error) {
+	return m.FindPDA(VaultSeeds(marketID))
+}
+
+// FindOutcomeMintPDA derives the address of the outcome token mint of a market outcome
+func (m *PDAManager) FindOutcomeMintPDA(marketID string, outcome uint8) (solana.PublicKey, uint8, error) {
+	return m.FindPDA(OutcomeMintSeeds(marketID, outcome))
+}
+
+// FindOrderPDA derives the address of a limit order account
+func (m *PDAManager) FindOrderPDA(orderID string) (solana.PublicKey, uint8, error) {
+	return m.FindPDA(OrderSeeds(orderID))
+}
+
+// FindFeeVaultPDA derives the address of the vault holding a market's fees for a recipient
+func (m *PDAManager) FindFeeVaultPDA(marketID string, recipient uint8) (solana.PublicKey, uint8, error) {
+	return m.FindPDA(FeeVaultSeeds(marketID, recipient))
+}
+
+// FindVotePDA derives the address of a committee member's vote on a market
+func (m *PDAManager) FindVotePDA(marketID, voter string) (solana.PublicKey, uint8, error) {
+	seeds, err := VoteSeeds(marketID, voter)
+	if err != nil {
+		return solana.PublicKey{}, 0, err
+	}
+	return m.FindPDA(seeds)
+}
+
+// FindConfigPDA derives the program config address
+func (m *PDAManager) FindConfigPDA() (solana.PublicKey, uint8, error) {
+	return m.FindPDA(ConfigSeeds())
+}
+
+// FindMarketIndexPDA derives the global market index address
+func (m *PDAManager) FindMarketIndexPDA() (solana.PublicKey, uint8, error) {
+	return m.FindPDA(MarketIndexSeeds())
+}
+
+// FindUserPositionIndexPDA derives the position index address for a user
+func (m *PDAManager) FindUserPositionIndexPDA(userID string) (solana.PublicKey, uint8, error) {
+	seeds, err := UserPositionIndexSeeds(userID)
+	if err != nil {
+		return solana.PublicKey{}, 0, err
+	}
+	return m.FindPDA(seeds)
+}
+
+// FindMarketPositionIndexPDA derives the position index address for a market
+func (m *PDAManager) FindMarketPositionIndexPDA(marketID string) (solana.PublicKey, uint8, error) {
+	return m.FindPDA(MarketPositionIndexSeeds(marketID))
+}
+
+// FindPDA derives an address from arbitrary seeds, caching the result
+func (m *PDAManager) FindPDA(seeds [][]byte) (solana.PublicKey, uint8, error) {
+	if err := validateSeeds(seeds); err != nil {
+		return solana.PublicKey{}, 0, err
+	}
+
+	key := cacheKey(seeds)
+
+	m.mu.RLock()
+	cached, ok := m.cache[key]
+	m.mu.RUnlock()
+	if ok {
+		return cached.address, cached.bump, nil
+	}
+
+	address, bump, err := solana.FindProgramAddress(copySeeds(seeds), m.program.ID())
+	if err != nil {
+		return solana.PublicKey{}, 0, err
+	}
+
+	m.mu.Lock()
+	m.cache[key] = derivedAddress{address: address, bump: bump}
+	m.mu.Unlock()
+
+	return address, bump, nil
+}
+
+// VerifyPDA checks that address is the program address for seeds and bump
+func (m *PDAManager) VerifyPDA(address solana.PublicKey, seeds [][]byte, bump uint8) error {
+	if err := validateSeeds(seeds); err != nil {
+		return err
+	}
+
+	expected, err := solana.CreateProgramAddress(append(copySeeds(seeds), []byte{bump}), m.program.ID())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPDA, err)
+	}
+	if !expected.Equals(address) {
+		return ErrInvalidPDA
+	}
+	return nil
+}
+
+// MarketSeeds returns the seeds for a market account
+func MarketSeeds(marketID string) [][]byte {
+	return [][]byte{[]byte(MarketSeed), []byte(marketID)}
+}
+
+// PositionSeeds returns the seeds for a position account
+func PositionSeeds(marketID, userID string) ([][]byte, error) {
+	user, err := solana.PublicKeyFromBase58(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSeedUser, err)
+	}
+	return [][]byte{[]byte(PositionSeed), []byte(marketID), user[:]}, nil
+}
+
+// LiquiditySeeds returns the seeds for a liquidity position account
+func LiquiditySeeds(marketID, provider string) ([][]byte, error) {
+	key, err := solana.PublicKeyFromBase58(provider)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSeedUser, err)
+	}
+	return [][]byte{[]byte(LiquiditySeed), []byte(marketID), key[:]}, nil
+}
+
+// VaultSeeds returns the seeds for a market vault account
+func VaultSeeds(marketID string) [][]byte {
+	return [][]byte{[]byte(VaultSeed), []byte(marketID)}
+}
+
+// OutcomeMintSeeds returns the seeds for an outcome token mint, outcome being the
+// outcome index (the side wire value for YES/NO markets)
+func OutcomeMintSeeds(marketID string, outcome uint8) [][]byte {
+	return [][]byte{[]byte(OutcomeMintSeed), []byte(marketID), {outcome}}
+}
+
+// OrderSeeds returns the seeds for a limit order account
+func OrderSeeds(orderID string) [][]byte {
+	return [][]byte{[]byte(OrderSeed), []byte(orderID)}
+}
+
+// FeeVaultSeeds returns the seeds for a market fee vault, recipient being the
+// FeeRecipient wire value
+func FeeVaultSeeds(marketID string, recipient uint8) [][]byte {
+	return [][]byte{[]byte(FeeVaultSeed), []byte(marketID), {recipient}}
+}
+
+// VoteSeeds returns the seeds for a committee vote account
+func VoteSeeds(marketID, voter string) ([][]byte, error) {
+	key, err := solana.PublicKeyFromBase58(voter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSeedUser, err)
+	}
+	return [][]byte{[]byte(VoteSeed), []byte(marketID), key[:]}, nil
+}
+
+// ConfigSeeds returns the seeds for the program config
+func ConfigSeeds() [][]byte {
+	return [][]byte{[]byte(ConfigSeed)}
+}
+
+// MarketIndexSeeds returns the seeds for the global market index
+func MarketIndexSeeds() [][]byte {
+	return [][]byte{[]byte(MarketIndexSeed)}
+}
+
+// UserPositionIndexSeeds returns the seeds for a user's position index
+func UserPositionIndexSeeds(userID string) ([][]byte, error) {
+	user, err := solana.PublicKeyFromBase58(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSeedUser, err)
+	}
+	return [][]byte{[]byte(UserPositionIndexSeed), user[:]}, nil
+}
+
+// MarketPositionIndexSeeds returns the seeds for a market's position index
+func MarketPositionIndexSeeds(marketID string) [][]byte {
+	return [][]byte{[]byte(MarketPositionIndexSeed), []byte(marketID)}
+}
+
+func validateSeeds(seeds [][]byte) error {
+	// One slot is reserved for the bump seed
+	if len(seeds) >= solana.MaxSeeds {
+		return fmt.Errorf("too many pda seeds: %d", len(seeds))
+	}
+	for _, seed := range seeds {
+		if len(seed) > solana.MaxSeedLength {
+			return ErrSeedTooLong
+		}
+	}
+	return nil
+}
+
+func copySeeds(seeds [][]byte) [][]byte {
+	out := make([][]byte, len(seeds), len(seeds)+1)
+	copy(out, seeds)
+	return out
+}
+
+func cacheKey(seeds [][]byte) string {
+	var b strings.Builder
+	for _, seed := range seeds {
+		// Length-prefix each seed so different splits never share a key
+		fmt.Fprintf(&b, "%d:", len(seed))
+		b.Write(seed)
+	}
+	return b.String()
+}
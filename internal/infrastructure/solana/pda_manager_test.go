@@ -0,0 +1,71 @@
+package solana
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+func TestPDAManagerVerifyPDA(t *testing.T) {
+	m := NewPDAManager(NewProgram(solana.SystemProgramID))
+	user := solana.NewWallet().PublicKey().String()
+
+	market, marketBump, err := m.FindMarketPDA("market-1")
+	if err != nil {
+		t.Fatalf("FindMarketPDA() error = %v", err)
+	}
+	positionSeeds, err := PositionSeeds("market-1", user)
+	if err != nil {
+		t.Fatalf("PositionSeeds() error = %v", err)
+	}
+	position, positionBump, err := m.FindPositionPDA("market-1", user)
+	if err != nil {
+		t.Fatalf("FindPositionPDA() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		address solana.PublicKey
+		seeds   [][]byte
+		bump    uint8
+		wantErr error
+	}{
+		{"market", market, MarketSeeds("market-1"), marketBump, nil},
+		{"position", position, positionSeeds, positionBump, nil},
+		{"other market", market, MarketSeeds("market-2"), marketBump, ErrInvalidPDA},
+		{"position passed as market", position, MarketSeeds("market-1"), positionBump, ErrInvalidPDA},
+		{"seed too long", market, MarketSeeds(strings.Repeat("x", solana.MaxSeedLength+1)), marketBump, ErrSeedTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := m.VerifyPDA(tt.address, tt.seeds, tt.bump); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyPDA() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPDAManagerCachesDerivations(t *testing.T) {
+	m := NewPDAManager(NewProgram(solana.SystemProgramID))
+
+	first, bump, err := m.FindMarketPDA("market-1")
+	if err != nil {
+		t.Fatalf("FindMarketPDA() error = %v", err)
+	}
+	second, secondBump, err := m.FindMarketPDA("market-1")
+	if err != nil {
+		t.Fatalf("FindMarketPDA() error = %v", err)
+	}
+	if !first.Equals(second) || bump != secondBump {
+		t.Fatalf("FindMarketPDA() = %s/%d then %s/%d", first, bump, second, secondBump)
+	}
+}
+
+func TestPDAManagerRejectsInvalidUser(t *testing.T) {
+	m := NewPDAManager(NewProgram(solana.SystemProgramID))
+	if _, _, err := m.FindPositionPDA("market-1", "not a key"); !errors.Is(err, ErrInvalidSeedUser) {
+		t.Fatalf("FindPositionPDA() error = %v, want %v", err, ErrInvalidSeedUser)
+	}
+}
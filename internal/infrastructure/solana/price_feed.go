@@ -0,0 +1,73 @@
+package solana
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// Pyth price update account layout (PriceUpdateV2), as posted by the Pyth
+// receiver program: discriminator, write authority, verification level, then
+// the price feed message. The verification level is a Borsh enum, so the
+// message starts one byte later for partially verified updates.
+const (
+	priceUpdateLevelOffset = DiscriminatorSize + 32
+
+	verificationPartial uint8 = 0 // Followed by the number of signatures
+	verificationFull    uint8 = 1
+
+	priceMessageFeedIDOffset      = 0
+	priceMessagePriceOffset       = 32
+	priceMessageConfOffset        = 40
+	priceMessageExponentOffset    = 48
+	priceMessagePublishOffset     = 52
+	priceMessagePrevPublishOffset = 60
+	priceMessageSize              = 84 // Includes the EMA price and confidence, which are not decoded
+)
+
+// PriceUpdateAccountDiscriminator identifies PriceUpdateV2 accounts of the Pyth receiver program
+var PriceUpdateAccountDiscriminator = NewDiscriminator("PriceUpdateV2")
+
+var (
+	ErrInvalidPriceAccount = errors.New("invalid price update account")
+)
+
+// DecodePriceFeed reads the price posted in a Pyth-style price update
+// account owned by feedProgram
+func DecodePriceFeed(account *entities.Account, feedProgram solana.PublicKey) (*entities.PriceFeed, error) {
+	data := account.Data
+	if !account.Owner.Equals(feedProgram) || len(data) <= priceUpdateLevelOffset {
+		return nil, ErrInvalidPriceAccount
+	}
+	if !bytes.Equal(data[:DiscriminatorSize], PriceUpdateAccountDiscriminator[:]) {
+		return nil, ErrInvalidPriceAccount
+	}
+
+	var message int
+	switch data[priceUpdateLevelOffset] {
+	case verificationPartial:
+		message = priceUpdateLevelOffset + 2
+	case verificationFull:
+		message = priceUpdateLevelOffset + 1
+	default:
+		return nil, ErrInvalidPriceAccount
+	}
+	if len(data) < message+priceMessageSize {
+		return nil, ErrInvalidPriceAccount
+	}
+	msg := data[message:]
+
+	return &entities.PriceFeed{
+		FeedID:          solana.PublicKeyFromBytes(msg[priceMessageFeedIDOffset:priceMessagePriceOffset]).String(),
+		Price:           int64(binary.LittleEndian.Uint64(msg[priceMessagePriceOffset:])),
+		Confidence:      binary.LittleEndian.Uint64(msg[priceMessageConfOffset:]),
+		Exponent:        int32(binary.LittleEndian.Uint32(msg[priceMessageExponentOffset:])),
+		PublishTime:     time.Unix(int64(binary.LittleEndian.Uint64(msg[priceMessagePublishOffset:])), 0),
+		PrevPublishTime: time.Unix(int64(binary.LittleEndian.Uint64(msg[priceMessagePrevPublishOffset:])), 0),
+		Verified:        data[priceUpdateLevelOffset] == verificationFull,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package solana
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// priceUpdateData builds a PriceUpdateV2 account with the given verification level bytes
+func priceUpdateData(feedID solana.PublicKey, level []byte, price int64, publish, prev int64) []byte {
+	data := append([]byte{}, PriceUpdateAccountDiscriminator[:]...)
+	data = append(data, make([]byte, 32)...) // Write authority
+	data = append(data, level...)
+	data = append(data, feedID[:]...)
+	data = binary.LittleEndian.AppendUint64(data, uint64(price))
+	data = binary.LittleEndian.AppendUint64(data, 7) // Confidence
+	exponent := int32(-8)
+	data = binary.LittleEndian.AppendUint32(data, uint32(exponent))
+	data = binary.LittleEndian.AppendUint64(data, uint64(publish))
+	data = binary.LittleEndian.AppendUint64(data, uint64(prev))
+	data = append(data, make([]byte, 16)...)         // EMA price and confidence
+	return binary.LittleEndian.AppendUint64(data, 1) // Posted slot
+}
+
+func TestDecodePriceFeed(t *testing.T) {
+	receiver := solana.NewWallet().PublicKey()
+	feedID := solana.NewWallet().PublicKey()
+	full := priceUpdateData(feedID, []byte{verificationFull}, 6_000_000, 1_700_000_001, 1_700_000_000)
+	partial := priceUpdateData(feedID, []byte{verificationPartial, 5}, -42, 1_700_000_001, 1_700_000_000)
+
+	tests := []struct {
+		name    string
+		account *entities.Account
+		want    *entities.PriceFeed
+		wantErr error
+	}{
+		{
+			"fully verified",
+			&entities.Account{Owner: receiver, Data: full},
+			&entities.PriceFeed{
+				FeedID:          feedID.String(),
+				Price:           6_000_000,
+				Confidence:      7,
+				Exponent:        -8,
+				PublishTime:     time.Unix(1_700_000_001, 0),
+				PrevPublishTime: time.Unix(1_700_000_000, 0),
+				Verified:        true,
+			},
+			nil,
+		},
+		{
+			"partially verified",
+			&entities.Account{Owner: receiver, Data: partial},
+			&entities.PriceFeed{
+				FeedID:          feedID.String(),
+				Price:           -42,
+				Confidence:      7,
+				Exponent:        -8,
+				PublishTime:     time.Unix(1_700_000_001, 0),
+				PrevPublishTime: time.Unix(1_700_000_000, 0),
+			},
+			nil,
+		},
+		{"wrong owner", &entities.Account{Owner: feedID, Data: full}, nil, ErrInvalidPriceAccount},
+		{"wrong discriminator", &entities.Account{Owner: receiver, Data: append(make([]byte, DiscriminatorSize), full[DiscriminatorSize:]...)}, nil, ErrInvalidPriceAccount},
+		{"unknown verification level", &entities.Account{Owner: receiver, Data: priceUpdateData(feedID, []byte{2}, 1, 1, 0)}, nil, ErrInvalidPriceAccount},
+		{"truncated", &entities.Account{Owner: receiver, Data: full[:priceUpdateLevelOffset+priceMessageSize]}, nil, ErrInvalidPriceAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodePriceFeed(tt.account, receiver)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodePriceFeed() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != nil && (got == nil || *got != *tt.want) {
+				t.Fatalf("DecodePriceFeed() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package solana
+
+import (
+	"github.com/gagliardetto/solana-go"
+)
+
+// Program represents the deployed Solana program
+type Program struct {
+	ProgramID solana.PublicKey
+}
+
+// NewProgram creates a new Program
+func NewProgram(programID solana.PublicKey) *Program {
+	return &Program{
+		ProgramID: programID,
+	}
+}
+
+// ID returns the program ID
+func (p *Program) ID() solana.PublicKey {
+	return p.ProgramID
+}
+
+// IsOwnerOf checks if the program owns the given account
+func (p *Program) IsOwnerOf(owner solana.PublicKey) bool {
+	return p.ProgramID.Equals(owner)
+}
@@ -0,0 +1,43 @@
+package solana
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Default cluster rent parameters
+const (
+	// AccountStorageOverhead is the number of bytes charged for every account on top of its data
+	AccountStorageOverhead uint64 = 128
+	// LamportsPerByteYear is the default rent rate
+	LamportsPerByteYear uint64 = 3480
+	// ExemptionThresholdYears is the number of years of rent an account must hold to be exempt
+	ExemptionThresholdYears uint64 = 2
+)
+
+// MinimumBalanceForRentExemption calculates the rent-exempt balance using default cluster parameters
+func MinimumBalanceForRentExemption(dataLength uint64) uint64 {
+	return (AccountStorageOverhead + dataLength) * LamportsPerByteYear * ExemptionThresholdYears
+}
+
+// RentCalculator calculates rent for accounts
+type RentCalculator struct {
+	rpcClient *rpc.Client
+}
+
+// NewRentCalculator creates a new RentCalculator
+func NewRentCalculator(rpcClient *rpc.Client) *RentCalculator {
+	return &RentCalculator{
+		rpcClient: rpcClient,
+	}
+}
+
+// MinimumBalance returns the rent-exempt balance for an account of dataLength bytes.
+// The cluster is queried when an RPC client is configured, otherwise default parameters are used.
+func (rc *RentCalculator) MinimumBalance(ctx context.Context, dataLength uint64) (uint64, error) {
+	if rc.rpcClient == nil {
+		return MinimumBalanceForRentExemption(dataLength), nil
+	}
+	return rc.rpcClient.GetMinimumBalanceForRentExemption(ctx, dataLength, rpc.CommitmentConfirmed)
+}
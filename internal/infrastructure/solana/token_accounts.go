@@ -0,0 +1,46 @@
+package solana
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+)
+
+// SPL Token account layouts
+const (
+	MintAccountSize  = 82
+	TokenAccountSize = 165
+
+	mintDecimalsOffset = 44
+	tokenAmountOffset  = 64
+)
+
+var (
+	ErrInvalidMint         = errors.New("invalid token mint account")
+	ErrInvalidTokenAccount = errors.New("invalid token account")
+)
+
+// FindAssociatedTokenAddress derives the associated token account of owner for mint.
+// owner may be a PDA, such as a market vault.
+func FindAssociatedTokenAddress(owner, mint solana.PublicKey) (solana.PublicKey, error) {
+	address, _, err := solana.FindAssociatedTokenAddress(owner, mint)
+	return address, err
+}
+
+// DecodeMintDecimals reads the decimals of an SPL Token mint account
+func DecodeMintDecimals(account *entities.Account) (uint8, error) {
+	if !account.Owner.Equals(solana.TokenProgramID) || len(account.Data) < MintAccountSize {
+		return 0, ErrInvalidMint
+	}
+	return account.Data[mintDecimalsOffset], nil
+}
+
+// DecodeTokenAmount reads the balance of an SPL Token account
+func DecodeTokenAmount(account *entities.Account) (uint64, error) {
+	if !account.Owner.Equals(solana.TokenProgramID) || len(account.Data) < TokenAccountSize {
+		return 0, ErrInvalidTokenAccount
+	}
+	return binary.LittleEndian.Uint64(account.Data[tokenAmountOffset:]), nil
+}
@@ -0,0 +1,75 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/polymarket/solana-program/pkg/utils"
+)
+
+// Version is the instruction encoding version written after the instruction tag.
+// It is bumped whenever the layout of an existing payload changes, so data
+// encoded for an older layout is rejected instead of being misread.
+const Version uint8 = 12
+
+// HeaderSize is the size of the [tag(1)][version(1)] prefix of every instruction
+const HeaderSize = 2
+
+// Maximum field lengths in bytes
+const (
+	MaxMarketIDLength    = utils.MaxIDLength // Must fit in a single PDA seed
+	MaxOrderIDLength     = utils.MaxIDLength
+	MaxTitleLength       = 200
+	MaxDescriptionLength = 1000
+	MaxCategoryLength    = 50
+	MaxOutcomeNameLength = 50
+)
+
+// MaxOutcomes is the maximum number of named outcomes of a categorical market
+const MaxOutcomes = 16
+
+// MaxCommitteeMembers is the maximum number of members of a resolver committee
+const MaxCommitteeMembers = 16
+
+var (
+	ErrInvalidInstructionData = errors.New("invalid instruction data")
+	ErrUnsupportedVersion     = errors.New("unsupported instruction version")
+)
+
+// Payload is an instruction body that can be encoded and decoded symmetrically
+type Payload interface {
+	Encode(w *Writer)
+	Decode(r *Reader) error
+}
+
+// DecodeHeader splits instruction data into its tag and payload.
+// The version byte must match Version.
+func DecodeHeader(data []byte) (uint8, []byte, error) {
+	if len(data) < HeaderSize {
+		return 0, nil, ErrInvalidInstructionData
+	}
+	if data[1] != Version {
+		return 0, nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[1])
+	}
+	return data[0], data[HeaderSize:], nil
+}
+
+// EncodeInstruction encodes a full instruction as [tag][version][payload]
+func EncodeInstruction(tag uint8, payload Payload) []byte {
+	w := NewWriter()
+	w.WriteU8(tag)
+	w.WriteU8(Version)
+	if payload != nil {
+		payload.Encode(w)
+	}
+	return w.Bytes()
+}
+
+// DecodePayload decodes data into payload, rejecting trailing bytes
+func DecodePayload(data []byte, payload Payload) error {
+	r := NewReader(data)
+	if err := payload.Decode(r); err != nil {
+		return err
+	}
+	return r.Finish()
+}
@@ -0,0 +1,186 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// payloads lists an empty value of every instruction payload
+func payloads() []Payload {
+	return []Payload{
+		&CreateMarketPayload{},
+		&ResolveMarketPayload{},
+		&ResolveFromOraclePayload{},
+		&ResolveScalarPayload{},
+		&CloseMarketPayload{},
+		&CreatePositionPayload{},
+		&SellPositionPayload{},
+		&AddLiquidityPayload{},
+		&RemoveLiquidityPayload{},
+		&ClaimWinningsPayload{},
+		&SplitCollateralPayload{},
+		&MergeOutcomeTokensPayload{},
+		&RedeemOutcomeTokensPayload{},
+		&PlaceOrderPayload{},
+		&CancelOrderPayload{},
+		&WithdrawFeesPayload{},
+		&ProposeResolutionPayload{},
+		&DisputeResolutionPayload{},
+		&FinalizeResolutionPayload{},
+		&ArbitrateResolutionPayload{},
+		&CreateCommitteePayload{},
+		&VoteResolutionPayload{},
+		&InitializeConfigPayload{},
+		&GrantRolePayload{},
+		&RevokeRolePayload{},
+		&TransferAdminPayload{},
+		&AcceptAdminPayload{},
+		&SetPausedPayload{},
+		&SetMarketFrozenPayload{},
+		&SetProtocolFeePayload{},
+	}
+}
+
+// fill sets every field of v to a distinct non-zero value derived from seed
+func fill(v reflect.Value, seed int) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fill(v.Field(i), seed*31+i+1)
+		}
+	case reflect.String:
+		v.SetString(fmt.Sprintf("field-%d", seed))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(seed%250 + 1))
+	case reflect.Int32, reflect.Int64:
+		v.SetInt(-int64(seed))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			v.Index(i).SetUint(uint64(seed + i))
+		}
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 3, 3)
+		for i := 0; i < s.Len(); i++ {
+			fill(s.Index(i), seed+i)
+		}
+		v.Set(s)
+	}
+}
+
+func TestPayloadRoundTrip(t *testing.T) {
+	for _, payload := range payloads() {
+		name := reflect.TypeOf(payload).Elem().Name()
+		t.Run(name, func(t *testing.T) {
+			fill(reflect.ValueOf(payload).Elem(), 1)
+
+			data := EncodeInstruction(7, payload)
+			tag, body, err := DecodeHeader(data)
+			if err != nil {
+				t.Fatalf("DecodeHeader() error = %v", err)
+			}
+			if tag != 7 {
+				t.Fatalf("DecodeHeader() tag = %d, want 7", tag)
+			}
+
+			decoded := reflect.New(reflect.TypeOf(payload).Elem()).Interface().(Payload)
+			if err := DecodePayload(body, decoded); err != nil {
+				t.Fatalf("DecodePayload() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded, payload) {
+				t.Fatalf("DecodePayload() = %+v, want %+v", decoded, payload)
+			}
+		})
+	}
+}
+
+func TestPayloadRejectsMalformedData(t *testing.T) {
+	for _, payload := range payloads() {
+		name := reflect.TypeOf(payload).Elem().Name()
+		t.Run(name, func(t *testing.T) {
+			fill(reflect.ValueOf(payload).Elem(), 1)
+			w := NewWriter()
+			payload.Encode(w)
+			body := w.Bytes()
+
+			decoded := func() Payload {
+				return reflect.New(reflect.TypeOf(payload).Elem()).Interface().(Payload)
+			}
+
+			for n := 0; n < len(body); n++ {
+				if err := DecodePayload(body[:n], decoded()); !errors.Is(err, ErrInvalidInstructionData) {
+					t.Fatalf("DecodePayload() of %d/%d bytes error = %v, want %v", n, len(body), err, ErrInvalidInstructionData)
+				}
+			}
+			if err := DecodePayload(append(body, 0), decoded()); !errors.Is(err, ErrInvalidInstructionData) {
+				t.Fatalf("DecodePayload() with a trailing byte error = %v, want %v", err, ErrInvalidInstructionData)
+			}
+		})
+	}
+}
+
+func TestDecodeHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"valid", []byte{3, Version, 9}, nil},
+		{"empty", nil, ErrInvalidInstructionData},
+		{"tag only", []byte{3}, ErrInvalidInstructionData},
+		{"unsupported version", []byte{3, Version + 1}, ErrUnsupportedVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeHeader(tt.data); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeHeader() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReaderLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		encode func(w *Writer)
+		decode func(r *Reader) error
+	}{
+		{
+			name:   "bool out of range",
+			encode: func(w *Writer) { w.WriteU8(2) },
+			decode: func(r *Reader) error { _, err := r.ReadBool(); return err },
+		},
+		{
+			name:   "string too long",
+			encode: func(w *Writer) { w.WriteString("too long") },
+			decode: func(r *Reader) error { _, err := r.ReadString("title", 7); return err },
+		},
+		{
+			name:   "too many strings",
+			encode: func(w *Writer) { w.WriteStrings([]string{"a", "b", "c"}) },
+			decode: func(r *Reader) error { _, err := r.ReadStrings("outcomes", 2, 10); return err },
+		},
+		{
+			name:   "too many keys",
+			encode: func(w *Writer) { w.WritePublicKeys(make([][32]byte, 3)) },
+			decode: func(r *Reader) error { _, err := r.ReadPublicKeys("members", 2); return err },
+		},
+		{
+			name:   "string length beyond the data",
+			encode: func(w *Writer) { w.WriteU32(1 << 31) },
+			decode: func(r *Reader) error { _, err := r.ReadString("title", 1<<32-1); return err },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWriter()
+			tt.encode(w)
+			if err := tt.decode(NewReader(w.Bytes())); !errors.Is(err, ErrInvalidInstructionData) {
+				t.Fatalf("error = %v, want %v", err, ErrInvalidInstructionData)
+			}
+		})
+	}
+}
@@ -0,0 +1,834 @@
+package codec
+
+// CreateMarketPayload is the body of a create market instruction.
+// Format: [title(str)][description(str)][category(str)][end_date(i64)][nonce(u64)][liquidity(u64)][pricing_model(u8)][fee_bps(u16)][collateral_mint(pubkey)][outcome_tokens(bool)][outcomes(u8 count, str...)][scalar(bool)][scalar_min(i64)][scalar_max(i64)][creator_fee_bps(u16)][oracle_kind(u8)][oracle_account(pubkey)][oracle_threshold(i64)][oracle_exponent(i32)][optimistic(bool)][proposal_bond(u64)][dispute_window(i64)][arbiter(pubkey)]
+type CreateMarketPayload struct {
+	Title        string
+	Description  string
+	Category     string
+	EndDate      int64  // Unix seconds
+	Nonce        uint64 // Unique per creator, used to derive the market ID
+	Liquidity    uint64 // LMSR liquidity parameter b, in collateral base units
+	PricingModel uint8
+	FeeBps       uint16 // Constant-product pool fee
+	// CollateralMint is the SPL token the market settles in; all zeros for native SOL
+	CollateralMint [32]byte
+	OutcomeTokens  bool
+	Outcomes       []string // Outcome names of a categorical market; empty for YES/NO
+	Scalar         bool     // LONG/SHORT market resolving to a value in [ScalarMin, ScalarMax]
+	ScalarMin      int64
+	ScalarMax      int64
+	CreatorFeeBps  uint16 // Creator fee on trades; the protocol fee is set in the program config
+	// OracleKind is the OracleKind wire value; 0 for markets resolved by their creator
+	OracleKind      uint8
+	OracleAccount   [32]byte
+	OracleThreshold int64
+	OracleExponent  int32
+	// Optimistic resolves the market through bonded proposals decided by Arbiter when disputed
+	Optimistic    bool
+	ProposalBond  uint64
+	DisputeWindow int64 // Seconds
+	Arbiter       [32]byte
+}
+
+// Encode writes the payload
+func (p *CreateMarketPayload) Encode(w *Writer) {
+	w.WriteString(p.Title)
+	w.WriteString(p.Description)
+	w.WriteString(p.Category)
+	w.WriteI64(p.EndDate)
+	w.WriteU64(p.Nonce)
+	w.WriteU64(p.Liquidity)
+	w.WriteU8(p.PricingModel)
+	w.WriteU16(p.FeeBps)
+	w.WritePublicKey(p.CollateralMint)
+	w.WriteBool(p.OutcomeTokens)
+	w.WriteStrings(p.Outcomes)
+	w.WriteBool(p.Scalar)
+	w.WriteI64(p.ScalarMin)
+	w.WriteI64(p.ScalarMax)
+	w.WriteU16(p.CreatorFeeBps)
+	w.WriteU8(p.OracleKind)
+	w.WritePublicKey(p.OracleAccount)
+	w.WriteI64(p.OracleThreshold)
+	w.WriteI32(p.OracleExponent)
+	w.WriteBool(p.Optimistic)
+	w.WriteU64(p.ProposalBond)
+	w.WriteI64(p.DisputeWindow)
+	w.WritePublicKey(p.Arbiter)
+}
+
+// Decode reads the payload
+func (p *CreateMarketPayload) Decode(r *Reader) error {
+	var err error
+	if p.Title, err = r.ReadString("title", MaxTitleLength); err != nil {
+		return err
+	}
+	if p.Description, err = r.ReadString("description", MaxDescriptionLength); err != nil {
+		return err
+	}
+	if p.Category, err = r.ReadString("category", MaxCategoryLength); err != nil {
+		return err
+	}
+	if p.EndDate, err = r.ReadI64(); err != nil {
+		return err
+	}
+	if p.Nonce, err = r.ReadU64(); err != nil {
+		return err
+	}
+	if p.Liquidity, err = r.ReadU64(); err != nil {
+		return err
+	}
+	if p.PricingModel, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.FeeBps, err = r.ReadU16(); err != nil {
+		return err
+	}
+	if p.CollateralMint, err = r.ReadPublicKey(); err != nil {
+		return err
+	}
+	if p.OutcomeTokens, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if p.Outcomes, err = r.ReadStrings("outcomes", MaxOutcomes, MaxOutcomeNameLength); err != nil {
+		return err
+	}
+	if p.Scalar, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if p.ScalarMin, err = r.ReadI64(); err != nil {
+		return err
+	}
+	if p.ScalarMax, err = r.ReadI64(); err != nil {
+		return err
+	}
+	if p.CreatorFeeBps, err = r.ReadU16(); err != nil {
+		return err
+	}
+	if p.OracleKind, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.OracleAccount, err = r.ReadPublicKey(); err != nil {
+		return err
+	}
+	if p.OracleThreshold, err = r.ReadI64(); err != nil {
+		return err
+	}
+	if p.OracleExponent, err = r.ReadI32(); err != nil {
+		return err
+	}
+	if p.Optimistic, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if p.ProposalBond, err = r.ReadU64(); err != nil {
+		return err
+	}
+	if p.DisputeWindow, err = r.ReadI64(); err != nil {
+		return err
+	}
+	p.Arbiter, err = r.ReadPublicKey()
+	return err
+}
+
+// ResolveMarketPayload is the body of a resolve market instruction.
+// Format: [market_id(str)][resolution(u8)][outcome(u8)]
+type ResolveMarketPayload struct {
+	MarketID   string
+	Resolution uint8
+	Outcome    uint8 // Winning outcome index of a categorical market
+}
+
+// Encode writes the payload
+func (p *ResolveMarketPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Resolution)
+	w.WriteU8(p.Outcome)
+}
+
+// Decode reads the payload
+func (p *ResolveMarketPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Resolution, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Outcome, err = r.ReadU8()
+	return err
+}
+
+// ResolveFromOraclePayload is the body of a resolve from oracle instruction.
+// Format: [market_id(str)][resolution(u8)][outcome(u8)][value(i64)]
+type ResolveFromOraclePayload struct {
+	MarketID   string
+	Resolution uint8 // Reported by resolver keys and committees, ignored for price feeds
+	Outcome    uint8
+	Value      int64
+}
+
+// Encode writes the payload
+func (p *ResolveFromOraclePayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Resolution)
+	w.WriteU8(p.Outcome)
+	w.WriteI64(p.Value)
+}
+
+// Decode reads the payload
+func (p *ResolveFromOraclePayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Resolution, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Value, err = r.ReadI64()
+	return err
+}
+
+// ResolveScalarPayload is the body of a resolve scalar instruction.
+// Format: [market_id(str)][value(i64)]
+type ResolveScalarPayload struct {
+	MarketID string
+	Value    int64
+}
+
+// Encode writes the payload
+func (p *ResolveScalarPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteI64(p.Value)
+}
+
+// Decode reads the payload
+func (p *ResolveScalarPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.Value, err = r.ReadI64()
+	return err
+}
+
+// CloseMarketPayload is the body of a close market instruction.
+// Format: [market_id(str)]
+type CloseMarketPayload struct {
+	MarketID string
+}
+
+// Encode writes the payload
+func (p *CloseMarketPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+}
+
+// Decode reads the payload
+func (p *CloseMarketPayload) Decode(r *Reader) error {
+	var err error
+	p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength)
+	return err
+}
+
+// CreatePositionPayload is the body of a create position instruction.
+// Format: [market_id(str)][outcome(u8)][shares(u64)][price(u64)][max_slippage_bps(u16)]
+type CreatePositionPayload struct {
+	MarketID       string
+	Outcome        uint8 // Outcome index; side wire value for YES/NO markets
+	Shares         uint64
+	Price          uint64 // Expected price per share in collateral base units
+	MaxSlippageBps uint16
+}
+
+// Encode writes the payload
+func (p *CreatePositionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Outcome)
+	w.WriteU64(p.Shares)
+	w.WriteU64(p.Price)
+	w.WriteU16(p.MaxSlippageBps)
+}
+
+// Decode reads the payload
+func (p *CreatePositionPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Shares, err = r.ReadU64(); err != nil {
+		return err
+	}
+	if p.Price, err = r.ReadU64(); err != nil {
+		return err
+	}
+	p.MaxSlippageBps, err = r.ReadU16()
+	return err
+}
+
+// SellPositionPayload is the body of a sell position instruction.
+// Format: [market_id(str)][shares(u64)][price(u64)][max_slippage_bps(u16)]
+type SellPositionPayload struct {
+	MarketID       string
+	Shares         uint64
+	Price          uint64 // Expected price per share in collateral base units
+	MaxSlippageBps uint16
+}
+
+// Encode writes the payload
+func (p *SellPositionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU64(p.Shares)
+	w.WriteU64(p.Price)
+	w.WriteU16(p.MaxSlippageBps)
+}
+
+// Decode reads the payload
+func (p *SellPositionPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Shares, err = r.ReadU64(); err != nil {
+		return err
+	}
+	if p.Price, err = r.ReadU64(); err != nil {
+		return err
+	}
+	p.MaxSlippageBps, err = r.ReadU16()
+	return err
+}
+
+// AddLiquidityPayload is the body of an add liquidity instruction.
+// Format: [market_id(str)][amount(u64)]
+type AddLiquidityPayload struct {
+	MarketID string
+	Amount   uint64 // Collateral base units to deposit
+}
+
+// Encode writes the payload
+func (p *AddLiquidityPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU64(p.Amount)
+}
+
+// Decode reads the payload
+func (p *AddLiquidityPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.Amount, err = r.ReadU64()
+	return err
+}
+
+// RemoveLiquidityPayload is the body of a remove liquidity instruction.
+// Format: [market_id(str)][lp_shares(u64)]
+type RemoveLiquidityPayload struct {
+	MarketID string
+	LPShares uint64
+}
+
+// Encode writes the payload
+func (p *RemoveLiquidityPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU64(p.LPShares)
+}
+
+// Decode reads the payload
+func (p *RemoveLiquidityPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.LPShares, err = r.ReadU64()
+	return err
+}
+
+// ClaimWinningsPayload is the body of a claim winnings instruction.
+// Format: [market_id(str)]
+type ClaimWinningsPayload struct {
+	MarketID string
+}
+
+// Encode writes the payload
+func (p *ClaimWinningsPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+}
+
+// Decode reads the payload
+func (p *ClaimWinningsPayload) Decode(r *Reader) error {
+	var err error
+	p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength)
+	return err
+}
+
+// SplitCollateralPayload is the body of a split collateral instruction.
+// Format: [market_id(str)][amount(u64)]
+type SplitCollateralPayload struct {
+	MarketID string
+	Amount   uint64
+}
+
+// Encode writes the payload
+func (p *SplitCollateralPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU64(p.Amount)
+}
+
+// Decode reads the payload
+func (p *SplitCollateralPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.Amount, err = r.ReadU64()
+	return err
+}
+
+// MergeOutcomeTokensPayload is the body of a merge outcome tokens instruction.
+// Format: [market_id(str)][amount(u64)]
+type MergeOutcomeTokensPayload struct {
+	MarketID string
+	Amount   uint64
+}
+
+// Encode writes the payload
+func (p *MergeOutcomeTokensPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU64(p.Amount)
+}
+
+// Decode reads the payload
+func (p *MergeOutcomeTokensPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.Amount, err = r.ReadU64()
+	return err
+}
+
+// RedeemOutcomeTokensPayload is the body of a redeem outcome tokens instruction.
+// Format: [market_id(str)][outcome(u8)][amount(u64)]
+type RedeemOutcomeTokensPayload struct {
+	MarketID string
+	Outcome  uint8
+	Amount   uint64
+}
+
+// Encode writes the payload
+func (p *RedeemOutcomeTokensPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Outcome)
+	w.WriteU64(p.Amount)
+}
+
+// Decode reads the payload
+func (p *RedeemOutcomeTokensPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Amount, err = r.ReadU64()
+	return err
+}
+
+// PlaceOrderPayload is the body of a place order instruction.
+// Format: [market_id(str)][outcome(u8)][side(u8)][price(u64)][size(u64)][nonce(u64)]
+type PlaceOrderPayload struct {
+	MarketID string
+	Outcome  uint8
+	Side     uint8
+	Price    uint64 // Limit price per full share
+	Size     uint64
+	Nonce    uint64 // Unique per user per market, used to derive the order ID
+}
+
+// Encode writes the payload
+func (p *PlaceOrderPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Outcome)
+	w.WriteU8(p.Side)
+	w.WriteU64(p.Price)
+	w.WriteU64(p.Size)
+	w.WriteU64(p.Nonce)
+}
+
+// Decode reads the payload
+func (p *PlaceOrderPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Side, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Price, err = r.ReadU64(); err != nil {
+		return err
+	}
+	if p.Size, err = r.ReadU64(); err != nil {
+		return err
+	}
+	p.Nonce, err = r.ReadU64()
+	return err
+}
+
+// CancelOrderPayload is the body of a cancel order instruction.
+// Format: [order_id(str)]
+type CancelOrderPayload struct {
+	OrderID string
+}
+
+// Encode writes the payload
+func (p *CancelOrderPayload) Encode(w *Writer) {
+	w.WriteString(p.OrderID)
+}
+
+// Decode reads the payload
+func (p *CancelOrderPayload) Decode(r *Reader) error {
+	var err error
+	p.OrderID, err = r.ReadString("order_id", MaxOrderIDLength)
+	return err
+}
+
+// WithdrawFeesPayload is the body of a withdraw fees instruction.
+// Format: [market_id(str)][recipient(u8)]
+type WithdrawFeesPayload struct {
+	MarketID  string
+	Recipient uint8 // FeeRecipient wire value
+}
+
+// Encode writes the payload
+func (p *WithdrawFeesPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Recipient)
+}
+
+// Decode reads the payload
+func (p *WithdrawFeesPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.Recipient, err = r.ReadU8()
+	return err
+}
+
+// ProposeResolutionPayload is the body of a propose resolution instruction.
+// Format: [market_id(str)][resolution(u8)][outcome(u8)][value(i64)]
+type ProposeResolutionPayload struct {
+	MarketID   string
+	Resolution uint8
+	Outcome    uint8 // Winning outcome index of a categorical market
+	Value      int64 // Resolved value of a scalar market
+}
+
+// Encode writes the payload
+func (p *ProposeResolutionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Resolution)
+	w.WriteU8(p.Outcome)
+	w.WriteI64(p.Value)
+}
+
+// Decode reads the payload
+func (p *ProposeResolutionPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Resolution, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Value, err = r.ReadI64()
+	return err
+}
+
+// DisputeResolutionPayload is the body of a dispute resolution instruction.
+// Format: [market_id(str)]
+type DisputeResolutionPayload struct {
+	MarketID string
+}
+
+// Encode writes the payload
+func (p *DisputeResolutionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+}
+
+// Decode reads the payload
+func (p *DisputeResolutionPayload) Decode(r *Reader) error {
+	var err error
+	p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength)
+	return err
+}
+
+// FinalizeResolutionPayload is the body of a finalize resolution instruction.
+// Format: [market_id(str)]
+type FinalizeResolutionPayload struct {
+	MarketID string
+}
+
+// Encode writes the payload
+func (p *FinalizeResolutionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+}
+
+// Decode reads the payload
+func (p *FinalizeResolutionPayload) Decode(r *Reader) error {
+	var err error
+	p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength)
+	return err
+}
+
+// ArbitrateResolutionPayload is the body of an arbitrate resolution instruction.
+// Format: [market_id(str)][resolution(u8)][outcome(u8)][value(i64)]
+type ArbitrateResolutionPayload struct {
+	MarketID   string
+	Resolution uint8
+	Outcome    uint8 // Winning outcome index of a categorical market
+	Value      int64 // Resolved value of a scalar market
+}
+
+// Encode writes the payload
+func (p *ArbitrateResolutionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Resolution)
+	w.WriteU8(p.Outcome)
+	w.WriteI64(p.Value)
+}
+
+// Decode reads the payload
+func (p *ArbitrateResolutionPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Resolution, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Value, err = r.ReadI64()
+	return err
+}
+
+// CreateCommitteePayload is the body of a create committee instruction.
+// Format: [threshold(u8)][member_count(u8)][member(pubkey)]...
+type CreateCommitteePayload struct {
+	Threshold uint8
+	Members   [][32]byte
+}
+
+// Encode writes the payload
+func (p *CreateCommitteePayload) Encode(w *Writer) {
+	w.WriteU8(p.Threshold)
+	w.WritePublicKeys(p.Members)
+}
+
+// Decode reads the payload
+func (p *CreateCommitteePayload) Decode(r *Reader) error {
+	var err error
+	if p.Threshold, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Members, err = r.ReadPublicKeys("members", MaxCommitteeMembers)
+	return err
+}
+
+// VoteResolutionPayload is the body of a vote resolution instruction.
+// Format: [market_id(str)][resolution(u8)][outcome(u8)][value(i64)]
+type VoteResolutionPayload struct {
+	MarketID   string
+	Resolution uint8
+	Outcome    uint8 // Winning outcome index of a categorical market
+	Value      int64 // Resolved value of a scalar market
+}
+
+// Encode writes the payload
+func (p *VoteResolutionPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteU8(p.Resolution)
+	w.WriteU8(p.Outcome)
+	w.WriteI64(p.Value)
+}
+
+// Decode reads the payload
+func (p *VoteResolutionPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	if p.Resolution, err = r.ReadU8(); err != nil {
+		return err
+	}
+	if p.Outcome, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Value, err = r.ReadI64()
+	return err
+}
+
+// InitializeConfigPayload is the body of an initialize config instruction.
+// The signer becomes the admin, so the body is empty.
+// Format: (empty)
+type InitializeConfigPayload struct{}
+
+// Encode writes the payload
+func (p *InitializeConfigPayload) Encode(w *Writer) {}
+
+// Decode reads the payload
+func (p *InitializeConfigPayload) Decode(r *Reader) error {
+	return nil
+}
+
+// GrantRolePayload is the body of a grant role instruction.
+// Format: [role(u8)][key(pubkey)]
+type GrantRolePayload struct {
+	Role uint8
+	Key  [32]byte
+}
+
+// Encode writes the payload
+func (p *GrantRolePayload) Encode(w *Writer) {
+	w.WriteU8(p.Role)
+	w.WritePublicKey(p.Key)
+}
+
+// Decode reads the payload
+func (p *GrantRolePayload) Decode(r *Reader) error {
+	var err error
+	if p.Role, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Key, err = r.ReadPublicKey()
+	return err
+}
+
+// RevokeRolePayload is the body of a revoke role instruction.
+// Format: [role(u8)][key(pubkey)]
+type RevokeRolePayload struct {
+	Role uint8
+	Key  [32]byte
+}
+
+// Encode writes the payload
+func (p *RevokeRolePayload) Encode(w *Writer) {
+	w.WriteU8(p.Role)
+	w.WritePublicKey(p.Key)
+}
+
+// Decode reads the payload
+func (p *RevokeRolePayload) Decode(r *Reader) error {
+	var err error
+	if p.Role, err = r.ReadU8(); err != nil {
+		return err
+	}
+	p.Key, err = r.ReadPublicKey()
+	return err
+}
+
+// TransferAdminPayload is the body of a transfer admin instruction.
+// Format: [new_admin(pubkey)]
+type TransferAdminPayload struct {
+	NewAdmin [32]byte
+}
+
+// Encode writes the payload
+func (p *TransferAdminPayload) Encode(w *Writer) {
+	w.WritePublicKey(p.NewAdmin)
+}
+
+// Decode reads the payload
+func (p *TransferAdminPayload) Decode(r *Reader) error {
+	var err error
+	p.NewAdmin, err = r.ReadPublicKey()
+	return err
+}
+
+// AcceptAdminPayload is the body of an accept admin instruction.
+// The signer is the pending admin, so the body is empty.
+// Format: (empty)
+type AcceptAdminPayload struct{}
+
+// Encode writes the payload
+func (p *AcceptAdminPayload) Encode(w *Writer) {}
+
+// Decode reads the payload
+func (p *AcceptAdminPayload) Decode(r *Reader) error {
+	return nil
+}
+
+// SetPausedPayload is the body of a set paused instruction.
+// Format: [paused(bool)]
+type SetPausedPayload struct {
+	Paused bool
+}
+
+// Encode writes the payload
+func (p *SetPausedPayload) Encode(w *Writer) {
+	w.WriteBool(p.Paused)
+}
+
+// Decode reads the payload
+func (p *SetPausedPayload) Decode(r *Reader) error {
+	var err error
+	p.Paused, err = r.ReadBool()
+	return err
+}
+
+// SetProtocolFeePayload is the body of a set protocol fee instruction.
+// Format: [protocol_fee_bps(u16)]
+type SetProtocolFeePayload struct {
+	ProtocolFeeBps uint16
+}
+
+// Encode writes the payload
+func (p *SetProtocolFeePayload) Encode(w *Writer) {
+	w.WriteU16(p.ProtocolFeeBps)
+}
+
+// Decode reads the payload
+func (p *SetProtocolFeePayload) Decode(r *Reader) error {
+	var err error
+	p.ProtocolFeeBps, err = r.ReadU16()
+	return err
+}
+
+// SetMarketFrozenPayload is the body of a set market frozen instruction.
+// Format: [market_id(str)][frozen(bool)]
+type SetMarketFrozenPayload struct {
+	MarketID string
+	Frozen   bool
+}
+
+// Encode writes the payload
+func (p *SetMarketFrozenPayload) Encode(w *Writer) {
+	w.WriteString(p.MarketID)
+	w.WriteBool(p.Frozen)
+}
+
+// Decode reads the payload
+func (p *SetMarketFrozenPayload) Decode(r *Reader) error {
+	var err error
+	if p.MarketID, err = r.ReadString("market_id", MaxMarketIDLength); err != nil {
+		return err
+	}
+	p.Frozen, err = r.ReadBool()
+	return err
+}
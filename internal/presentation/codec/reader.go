@@ -0,0 +1,174 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Reader reads little-endian fields from instruction data without ever
+// reading past the end of the buffer
+type Reader struct {
+	data   []byte
+	offset int
+}
+
+// NewReader creates a new Reader
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data}
+}
+
+// Remaining returns the number of unread bytes
+func (r *Reader) Remaining() int {
+	return len(r.data) - r.offset
+}
+
+// Finish checks that all data has been consumed
+func (r *Reader) Finish() error {
+	if r.Remaining() != 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidInstructionData, r.Remaining())
+	}
+	return nil
+}
+
+func (r *Reader) next(n int) ([]byte, error) {
+	if n < 0 || r.Remaining() < n {
+		return nil, fmt.Errorf("%w: need %d bytes at offset %d, have %d", ErrInvalidInstructionData, n, r.offset, r.Remaining())
+	}
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return b, nil
+}
+
+// ReadU8 reads a single byte
+func (r *Reader) ReadU8() (uint8, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// ReadBool reads a single byte that must be 0 or 1
+func (r *Reader) ReadBool() (bool, error) {
+	v, err := r.ReadU8()
+	if err != nil {
+		return false, err
+	}
+	switch v {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w: invalid bool %d", ErrInvalidInstructionData, v)
+	}
+}
+
+// ReadU16 reads a little-endian uint16
+func (r *Reader) ReadU16() (uint16, error) {
+	b, err := r.next(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+// ReadU32 reads a little-endian uint32
+func (r *Reader) ReadU32() (uint32, error) {
+	b, err := r.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+// ReadU64 reads a little-endian uint64
+func (r *Reader) ReadU64() (uint64, error) {
+	b, err := r.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// ReadI32 reads a little-endian int32
+func (r *Reader) ReadI32() (int32, error) {
+	v, err := r.ReadU32()
+	return int32(v), err
+}
+
+// ReadI64 reads a little-endian int64
+func (r *Reader) ReadI64() (int64, error) {
+	v, err := r.ReadU64()
+	return int64(v), err
+}
+
+// ReadPublicKey reads a fixed 32-byte public key
+func (r *Reader) ReadPublicKey() ([32]byte, error) {
+	var key [32]byte
+	b, err := r.next(len(key))
+	if err != nil {
+		return key, err
+	}
+	copy(key[:], b)
+	return key, nil
+}
+
+// ReadString reads a u32 length-prefixed string of at most maxLength bytes
+func (r *Reader) ReadString(field string, maxLength int) (string, error) {
+	length, err := r.ReadU32()
+	if err != nil {
+		return "", err
+	}
+	if uint64(length) > uint64(maxLength) {
+		return "", fmt.Errorf("%w: %s exceeds %d bytes", ErrInvalidInstructionData, field, maxLength)
+	}
+	b, err := r.next(int(length))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ReadStrings reads a u8 count-prefixed list of at most maxCount strings,
+// each of at most maxLength bytes
+func (r *Reader) ReadStrings(field string, maxCount, maxLength int) ([]string, error) {
+	count, err := r.ReadU8()
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > maxCount {
+		return nil, fmt.Errorf("%w: %s exceeds %d entries", ErrInvalidInstructionData, field, maxCount)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	list := make([]string, count)
+	for i := range list {
+		if list[i], err = r.ReadString(field, maxLength); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// ReadPublicKeys reads a u8 count-prefixed list of at most maxCount public keys
+func (r *Reader) ReadPublicKeys(field string, maxCount int) ([][32]byte, error) {
+	count, err := r.ReadU8()
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > maxCount {
+		return nil, fmt.Errorf("%w: %s exceeds %d entries", ErrInvalidInstructionData, field, maxCount)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	keys := make([][32]byte, count)
+	for i := range keys {
+		if keys[i], err = r.ReadPublicKey(); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,86 @@
+package codec
+
+import (
+	"encoding/binary"
+)
+
+// Writer writes little-endian fields in the same layout Reader expects
+type Writer struct {
+	buf []byte
+}
+
+// NewWriter creates a new Writer
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Bytes returns the encoded data
+func (w *Writer) Bytes() []byte {
+	return w.buf
+}
+
+// WriteU8 writes a single byte
+func (w *Writer) WriteU8(v uint8) {
+	w.buf = append(w.buf, v)
+}
+
+// WriteBool writes a bool as a single 0 or 1 byte
+func (w *Writer) WriteBool(v bool) {
+	if v {
+		w.WriteU8(1)
+	} else {
+		w.WriteU8(0)
+	}
+}
+
+// WriteU16 writes a little-endian uint16
+func (w *Writer) WriteU16(v uint16) {
+	w.buf = binary.LittleEndian.AppendUint16(w.buf, v)
+}
+
+// WriteU32 writes a little-endian uint32
+func (w *Writer) WriteU32(v uint32) {
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, v)
+}
+
+// WriteU64 writes a little-endian uint64
+func (w *Writer) WriteU64(v uint64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, v)
+}
+
+// WriteI32 writes a little-endian int32
+func (w *Writer) WriteI32(v int32) {
+	w.WriteU32(uint32(v))
+}
+
+// WriteI64 writes a little-endian int64
+func (w *Writer) WriteI64(v int64) {
+	w.WriteU64(uint64(v))
+}
+
+// WritePublicKey writes a fixed 32-byte public key
+func (w *Writer) WritePublicKey(key [32]byte) {
+	w.buf = append(w.buf, key[:]...)
+}
+
+// WriteString writes a u32 length-prefixed string
+func (w *Writer) WriteString(s string) {
+	w.WriteU32(uint32(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// WriteStrings writes a u8 count-prefixed list of strings
+func (w *Writer) WriteStrings(list []string) {
+	w.WriteU8(uint8(len(list)))
+	for _, s := range list {
+		w.WriteString(s)
+	}
+}
+
+// WritePublicKeys writes a u8 count-prefixed list of public keys
+func (w *Writer) WritePublicKeys(keys [][32]byte) {
+	w.WriteU8(uint8(len(keys)))
+	for _, key := range keys {
+		w.WritePublicKey(key)
+	}
+}
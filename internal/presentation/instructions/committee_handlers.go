@@ -0,0 +1,76 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleCreateCommittee handles the create committee instruction.
+// Accounts: [payer, committee account (signer)]
+func (h *InstructionHandler) handleCreateCommittee(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if !accounts[1].IsSigner {
+		return ErrInvalidAccounts
+	}
+
+	// Parse instruction data
+	// Format: [threshold(1)][member_count(1)][member(32)]...
+	var payload codec.CreateCommitteePayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	members := make([]string, len(payload.Members))
+	for i, member := range payload.Members {
+		members[i] = solana.PublicKeyFromBytes(member[:]).String()
+	}
+
+	input := usecases.CreateCommitteeInput{
+		Address:   accounts[1].PublicKey.String(),
+		Members:   members,
+		Threshold: payload.Threshold,
+	}
+
+	_, err := h.createCommitteeUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleVoteResolution handles the vote resolution instruction.
+// Accounts: [committee member (signer), market, committee account]
+func (h *InstructionHandler) handleVoteResolution(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][resolution(1)][outcome(1)][value(8)]
+	var payload codec.VoteResolutionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	resolution, err := entities.ParseResolution(payload.Resolution)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.VoteResolutionInput{
+		MarketID:   payload.MarketID,
+		Voter:      accounts[0].PublicKey.String(),
+		Resolution: resolution,
+		Outcome:    payload.Outcome,
+		Value:      payload.Value,
+	}
+
+	_, err = h.voteResolutionUseCase.Execute(ctx, input)
+	return err
+}
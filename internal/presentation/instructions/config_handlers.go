@@ -0,0 +1,176 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleInitializeConfig handles the initialize config instruction.
+// Accounts: [admin (signer), config]
+func (h *InstructionHandler) handleInitializeConfig(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: (empty)
+	var payload codec.InitializeConfigPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.InitializeConfigInput{
+		Admin: accounts[0].PublicKey.String(),
+	}
+
+	_, err := h.initializeConfigUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleGrantRole handles the grant role instruction.
+// Accounts: [admin (signer), config]
+func (h *InstructionHandler) handleGrantRole(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [role(1)][key(32)]
+	var payload codec.GrantRolePayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	role, err := entities.ParseRole(payload.Role)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.GrantRoleInput{
+		Caller: accounts[0].PublicKey.String(),
+		Role:   role,
+		Key:    solana.PublicKeyFromBytes(payload.Key[:]).String(),
+	}
+
+	_, err = h.grantRoleUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleRevokeRole handles the revoke role instruction.
+// Accounts: [admin (signer), config]
+func (h *InstructionHandler) handleRevokeRole(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [role(1)][key(32)]
+	var payload codec.RevokeRolePayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	role, err := entities.ParseRole(payload.Role)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.RevokeRoleInput{
+		Caller: accounts[0].PublicKey.String(),
+		Role:   role,
+		Key:    solana.PublicKeyFromBytes(payload.Key[:]).String(),
+	}
+
+	_, err = h.revokeRoleUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleTransferAdmin handles the transfer admin instruction.
+// Accounts: [admin (signer), config]
+func (h *InstructionHandler) handleTransferAdmin(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [new_admin(32)]
+	var payload codec.TransferAdminPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.TransferAdminInput{
+		Caller:   accounts[0].PublicKey.String(),
+		NewAdmin: solana.PublicKeyFromBytes(payload.NewAdmin[:]).String(),
+	}
+
+	_, err := h.transferAdminUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleAcceptAdmin handles the accept admin instruction.
+// Accounts: [pending admin (signer), config]
+func (h *InstructionHandler) handleAcceptAdmin(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: (empty)
+	var payload codec.AcceptAdminPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.AcceptAdminInput{
+		Caller: accounts[0].PublicKey.String(),
+	}
+
+	_, err := h.acceptAdminUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleSetProtocolFee handles the set protocol fee instruction.
+// Accounts: [admin (signer), config]
+func (h *InstructionHandler) handleSetProtocolFee(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [protocol_fee_bps(u16)]
+	var payload codec.SetProtocolFeePayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.SetProtocolFeeInput{
+		Caller:         accounts[0].PublicKey.String(),
+		ProtocolFeeBps: payload.ProtocolFeeBps,
+	}
+
+	_, err := h.setProtocolFeeUseCase.Execute(ctx, input)
+	return err
+}
@@ -0,0 +1,41 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleWithdrawFees handles the withdraw fees instruction
+func (h *InstructionHandler) handleWithdrawFees(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][recipient(1)]
+	var payload codec.WithdrawFeesPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	recipient, err := entities.ParseFeeRecipient(payload.Recipient)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.WithdrawFeesInput{
+		MarketID:  payload.MarketID,
+		Recipient: recipient,
+		Caller:    accounts[0].PublicKey.String(),
+	}
+
+	_, err = h.withdrawFeesUseCase.Execute(ctx, input)
+	return err
+}
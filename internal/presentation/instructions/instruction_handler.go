@@ -2,9 +2,12 @@ package instructions
 
 import (
 	"context"
+	"errors"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/services"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
 )
 
 // InstructionType represents the type of instruction
@@ -15,28 +18,140 @@ const (
 	InstructionResolveMarket
 	InstructionCreatePosition
 	InstructionCloseMarket
+	InstructionSellPosition
+	InstructionAddLiquidity
+	InstructionRemoveLiquidity
+	InstructionClaimWinnings
+	InstructionSplitCollateral
+	InstructionMergeOutcomeTokens
+	InstructionRedeemOutcomeTokens
+	InstructionResolveScalar
+	InstructionPlaceOrder
+	InstructionCancelOrder
+	InstructionWithdrawFees
+	InstructionResolveFromOracle
+	InstructionProposeResolution
+	InstructionDisputeResolution
+	InstructionFinalizeResolution
+	InstructionArbitrateResolution
+	InstructionCreateCommittee
+	InstructionVoteResolution
+	InstructionInitializeConfig
+	InstructionGrantRole
+	InstructionRevokeRole
+	InstructionTransferAdmin
+	InstructionAcceptAdmin
+	InstructionSetPaused
+	InstructionSetMarketFrozen
+	InstructionSetProtocolFee
 )
 
+// Encode builds the wire format of an instruction: [type(1)][version(1)][payload]
+func (t InstructionType) Encode(payload codec.Payload) []byte {
+	return codec.EncodeInstruction(uint8(t), payload)
+}
+
 // InstructionHandler handles Solana program instructions
 type InstructionHandler struct {
-	createMarketUseCase   *usecases.CreateMarketUseCase
-	resolveMarketUseCase  *usecases.ResolveMarketUseCase
-	createPositionUseCase *usecases.CreatePositionUseCase
-	closeMarketUseCase    *usecases.CloseMarketUseCase
+	validator                  *InstructionValidator
+	createMarketUseCase        *usecases.CreateMarketUseCase
+	resolveMarketUseCase       *usecases.ResolveMarketUseCase
+	createPositionUseCase      *usecases.CreatePositionUseCase
+	closeMarketUseCase         *usecases.CloseMarketUseCase
+	sellPositionUseCase        *usecases.SellPositionUseCase
+	addLiquidityUseCase        *usecases.AddLiquidityUseCase
+	removeLiquidityUseCase     *usecases.RemoveLiquidityUseCase
+	claimWinningsUseCase       *usecases.ClaimWinningsUseCase
+	splitUseCase               *usecases.SplitCollateralUseCase
+	mergeUseCase               *usecases.MergeOutcomeTokensUseCase
+	redeemUseCase              *usecases.RedeemOutcomeTokensUseCase
+	resolveScalarUseCase       *usecases.ResolveScalarUseCase
+	placeOrderUseCase          *usecases.PlaceOrderUseCase
+	cancelOrderUseCase         *usecases.CancelOrderUseCase
+	withdrawFeesUseCase        *usecases.WithdrawFeesUseCase
+	resolveFromOracleUseCase   *usecases.ResolveFromOracleUseCase
+	proposeResolutionUseCase   *usecases.ProposeResolutionUseCase
+	disputeResolutionUseCase   *usecases.DisputeResolutionUseCase
+	finalizeResolutionUseCase  *usecases.FinalizeResolutionUseCase
+	arbitrateResolutionUseCase *usecases.ArbitrateResolutionUseCase
+	createCommitteeUseCase     *usecases.CreateCommitteeUseCase
+	voteResolutionUseCase      *usecases.VoteResolutionUseCase
+	initializeConfigUseCase    *usecases.InitializeConfigUseCase
+	grantRoleUseCase           *usecases.GrantRoleUseCase
+	revokeRoleUseCase          *usecases.RevokeRoleUseCase
+	transferAdminUseCase       *usecases.TransferAdminUseCase
+	acceptAdminUseCase         *usecases.AcceptAdminUseCase
+	setPausedUseCase           *usecases.SetPausedUseCase
+	setMarketFrozenUseCase     *usecases.SetMarketFrozenUseCase
+	setProtocolFeeUseCase      *usecases.SetProtocolFeeUseCase
 }
 
 // NewInstructionHandler creates a new InstructionHandler
 func NewInstructionHandler(
+	validator *InstructionValidator,
 	createMarketUseCase *usecases.CreateMarketUseCase,
 	resolveMarketUseCase *usecases.ResolveMarketUseCase,
 	createPositionUseCase *usecases.CreatePositionUseCase,
 	closeMarketUseCase *usecases.CloseMarketUseCase,
+	sellPositionUseCase *usecases.SellPositionUseCase,
+	addLiquidityUseCase *usecases.AddLiquidityUseCase,
+	removeLiquidityUseCase *usecases.RemoveLiquidityUseCase,
+	claimWinningsUseCase *usecases.ClaimWinningsUseCase,
+	splitUseCase *usecases.SplitCollateralUseCase,
+	mergeUseCase *usecases.MergeOutcomeTokensUseCase,
+	redeemUseCase *usecases.RedeemOutcomeTokensUseCase,
+	resolveScalarUseCase *usecases.ResolveScalarUseCase,
+	placeOrderUseCase *usecases.PlaceOrderUseCase,
+	cancelOrderUseCase *usecases.CancelOrderUseCase,
+	withdrawFeesUseCase *usecases.WithdrawFeesUseCase,
+	resolveFromOracleUseCase *usecases.ResolveFromOracleUseCase,
+	proposeResolutionUseCase *usecases.ProposeResolutionUseCase,
+	disputeResolutionUseCase *usecases.DisputeResolutionUseCase,
+	finalizeResolutionUseCase *usecases.FinalizeResolutionUseCase,
+	arbitrateResolutionUseCase *usecases.ArbitrateResolutionUseCase,
+	createCommitteeUseCase *usecases.CreateCommitteeUseCase,
+	voteResolutionUseCase *usecases.VoteResolutionUseCase,
+	initializeConfigUseCase *usecases.InitializeConfigUseCase,
+	grantRoleUseCase *usecases.GrantRoleUseCase,
+	revokeRoleUseCase *usecases.RevokeRoleUseCase,
+	transferAdminUseCase *usecases.TransferAdminUseCase,
+	acceptAdminUseCase *usecases.AcceptAdminUseCase,
+	setPausedUseCase *usecases.SetPausedUseCase,
+	setMarketFrozenUseCase *usecases.SetMarketFrozenUseCase,
+	setProtocolFeeUseCase *usecases.SetProtocolFeeUseCase,
 ) *InstructionHandler {
 	return &InstructionHandler{
-		createMarketUseCase:   createMarketUseCase,
-		resolveMarketUseCase:  resolveMarketUseCase,
-		createPositionUseCase: createPositionUseCase,
-		closeMarketUseCase:    closeMarketUseCase,
+		validator:                  validator,
+		createMarketUseCase:        createMarketUseCase,
+		resolveMarketUseCase:       resolveMarketUseCase,
+		createPositionUseCase:      createPositionUseCase,
+		closeMarketUseCase:         closeMarketUseCase,
+		sellPositionUseCase:        sellPositionUseCase,
+		addLiquidityUseCase:        addLiquidityUseCase,
+		removeLiquidityUseCase:     removeLiquidityUseCase,
+		claimWinningsUseCase:       claimWinningsUseCase,
+		splitUseCase:               splitUseCase,
+		mergeUseCase:               mergeUseCase,
+		redeemUseCase:              redeemUseCase,
+		resolveScalarUseCase:       resolveScalarUseCase,
+		placeOrderUseCase:          placeOrderUseCase,
+		cancelOrderUseCase:         cancelOrderUseCase,
+		withdrawFeesUseCase:        withdrawFeesUseCase,
+		resolveFromOracleUseCase:   resolveFromOracleUseCase,
+		proposeResolutionUseCase:   proposeResolutionUseCase,
+		disputeResolutionUseCase:   disputeResolutionUseCase,
+		finalizeResolutionUseCase:  finalizeResolutionUseCase,
+		arbitrateResolutionUseCase: arbitrateResolutionUseCase,
+		createCommitteeUseCase:     createCommitteeUseCase,
+		voteResolutionUseCase:      voteResolutionUseCase,
+		initializeConfigUseCase:    initializeConfigUseCase,
+		grantRoleUseCase:           grantRoleUseCase,
+		revokeRoleUseCase:          revokeRoleUseCase,
+		transferAdminUseCase:       transferAdminUseCase,
+		acceptAdminUseCase:         acceptAdminUseCase,
+		setPausedUseCase:           setPausedUseCase,
+		setMarketFrozenUseCase:     setMarketFrozenUseCase,
+		setProtocolFeeUseCase:      setProtocolFeeUseCase,
 	}
 }
 
@@ -54,32 +169,114 @@ func (h *InstructionHandler) ProcessInstruction(ctx context.Context, instruction
 		return ErrInvalidInstruction
 	}
 
-	instructionType := InstructionType(instructionData[0])
+	tag, data, err := codec.DecodeHeader(instructionData)
+	if err != nil {
+		return err
+	}
 
-	switch instructionType {
+	return haltError(h.dispatch(ctx, InstructionType(tag), data, accounts))
+}
+
+// dispatch routes an instruction to its handler
+func (h *InstructionHandler) dispatch(ctx context.Context, instruction InstructionType, data []byte, accounts []*solana.AccountMeta) error {
+	switch instruction {
 	case InstructionCreateMarket:
-		return h.handleCreateMarket(ctx, instructionData[1:], accounts)
+		return h.handleCreateMarket(ctx, data, accounts)
 	case InstructionResolveMarket:
-		return h.handleResolveMarket(ctx, instructionData[1:], accounts)
+		return h.handleResolveMarket(ctx, data, accounts)
 	case InstructionCreatePosition:
-		return h.handleCreatePosition(ctx, instructionData[1:], accounts)
+		return h.handleCreatePosition(ctx, data, accounts)
 	case InstructionCloseMarket:
-		return h.handleCloseMarket(ctx, instructionData[1:], accounts)
+		return h.handleCloseMarket(ctx, data, accounts)
+	case InstructionSellPosition:
+		return h.handleSellPosition(ctx, data, accounts)
+	case InstructionAddLiquidity:
+		return h.handleAddLiquidity(ctx, data, accounts)
+	case InstructionRemoveLiquidity:
+		return h.handleRemoveLiquidity(ctx, data, accounts)
+	case InstructionClaimWinnings:
+		return h.handleClaimWinnings(ctx, data, accounts)
+	case InstructionSplitCollateral:
+		return h.handleSplitCollateral(ctx, data, accounts)
+	case InstructionMergeOutcomeTokens:
+		return h.handleMergeOutcomeTokens(ctx, data, accounts)
+	case InstructionRedeemOutcomeTokens:
+		return h.handleRedeemOutcomeTokens(ctx, data, accounts)
+	case InstructionResolveScalar:
+		return h.handleResolveScalar(ctx, data, accounts)
+	case InstructionPlaceOrder:
+		return h.handlePlaceOrder(ctx, data, accounts)
+	case InstructionCancelOrder:
+		return h.handleCancelOrder(ctx, data, accounts)
+	case InstructionWithdrawFees:
+		return h.handleWithdrawFees(ctx, data, accounts)
+	case InstructionResolveFromOracle:
+		return h.handleResolveFromOracle(ctx, data, accounts)
+	case InstructionProposeResolution:
+		return h.handleProposeResolution(ctx, data, accounts)
+	case InstructionDisputeResolution:
+		return h.handleDisputeResolution(ctx, data, accounts)
+	case InstructionFinalizeResolution:
+		return h.handleFinalizeResolution(ctx, data, accounts)
+	case InstructionArbitrateResolution:
+		return h.handleArbitrateResolution(ctx, data, accounts)
+	case InstructionCreateCommittee:
+		return h.handleCreateCommittee(ctx, data, accounts)
+	case InstructionVoteResolution:
+		return h.handleVoteResolution(ctx, data, accounts)
+	case InstructionInitializeConfig:
+		return h.handleInitializeConfig(ctx, data, accounts)
+	case InstructionGrantRole:
+		return h.handleGrantRole(ctx, data, accounts)
+	case InstructionRevokeRole:
+		return h.handleRevokeRole(ctx, data, accounts)
+	case InstructionTransferAdmin:
+		return h.handleTransferAdmin(ctx, data, accounts)
+	case InstructionAcceptAdmin:
+		return h.handleAcceptAdmin(ctx, data, accounts)
+	case InstructionSetPaused:
+		return h.handleSetPaused(ctx, data, accounts)
+	case InstructionSetMarketFrozen:
+		return h.handleSetMarketFrozen(ctx, data, accounts)
+	case InstructionSetProtocolFee:
+		return h.handleSetProtocolFee(ctx, data, accounts)
 	default:
 		return ErrUnknownInstruction
 	}
 }
 
+// haltError reports instructions rejected by the emergency pause or a market
+// freeze as ErrProgramPaused or ErrMarketFrozen, so clients can tell a halt
+// from a failed instruction
+func haltError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrProtocolPaused):
+		return ErrProgramPaused
+	case errors.Is(err, services.ErrMarketFrozen):
+		return ErrMarketFrozen
+	default:
+		return err
+	}
+}
+
 var (
 	ErrInvalidInstruction = &InstructionError{Message: "invalid instruction"}
 	ErrUnknownInstruction = &InstructionError{Message: "unknown instruction"}
+	ErrProgramPaused      = &InstructionError{Message: "program is paused", Err: services.ErrProtocolPaused}
+	ErrMarketFrozen       = &InstructionError{Message: "market is frozen", Err: services.ErrMarketFrozen}
 )
 
 // InstructionError represents an instruction-related error
 type InstructionError struct {
 	Message string
+	Err     error // Underlying domain error, if any
 }
 
 func (e *InstructionError) Error() string {
 	return e.Message
 }
+
+// Unwrap returns the underlying domain error
+func (e *InstructionError) Unwrap() error {
+	return e.Err
+}
@@ -1,8 +1,7 @@
 package instructions
 
 import (
-	"errors"
-	"github.com/gagliardetto/solana-go"
+	solanago "github.com/gagliardetto/solana-go"
 	"github.com/polymarket/solana-program/internal/infrastructure/solana"
 )
 
@@ -20,23 +19,16 @@ func NewInstructionValidator(accountValidator *solana.AccountValidator) *Instruc
 
 // ValidateCreateMarket validates create market instruction
 func (iv *InstructionValidator) ValidateCreateMarket(
-	accounts []*solana.AccountMeta,
-	creator solana.PublicKey,
-	marketPDA solana.PublicKey,
+	accounts []*solanago.AccountMeta,
+	creator solanago.PublicKey,
+	marketPDA solanago.PublicKey,
 ) error {
 	if len(accounts) < 3 {
 		return ErrInvalidAccounts
 	}
 	
 	// Validate creator is signer
-	signers := make([]solana.PublicKey, 0)
-	for _, acc := range accounts {
-		if acc.IsSigner {
-			signers = append(signers, acc.PublicKey)
-		}
-	}
-	
-	if err := iv.accountValidator.ValidateSigner(creator, signers); err != nil {
+	if err := iv.accountValidator.ValidateSigner(creator, signers(accounts)); err != nil {
 		return err
 	}
 	
@@ -55,44 +47,30 @@ func (iv *InstructionValidator) ValidateCreateMarket(
 
 // ValidateResolveMarket validates resolve market instruction
 func (iv *InstructionValidator) ValidateResolveMarket(
-	accounts []*solana.AccountMeta,
-	resolver solana.PublicKey,
-	marketPDA solana.PublicKey,
+	accounts []*solanago.AccountMeta,
+	resolver solanago.PublicKey,
+	marketPDA solanago.PublicKey,
 ) error {
 	if len(accounts) < 2 {
 		return ErrInvalidAccounts
 	}
 	
 	// Validate resolver is signer
-	signers := make([]solana.PublicKey, 0)
-	for _, acc := range accounts {
-		if acc.IsSigner {
-			signers = append(signers, acc.PublicKey)
-		}
-	}
-	
-	return iv.accountValidator.ValidateSigner(resolver, signers)
+	return iv.accountValidator.ValidateSigner(resolver, signers(accounts))
 }
 
 // ValidateCreatePosition validates create position instruction
 func (iv *InstructionValidator) ValidateCreatePosition(
-	accounts []*solana.AccountMeta,
-	user solana.PublicKey,
-	positionPDA solana.PublicKey,
+	accounts []*solanago.AccountMeta,
+	user solanago.PublicKey,
+	positionPDA solanago.PublicKey,
 ) error {
 	if len(accounts) < 3 {
 		return ErrInvalidAccounts
 	}
 	
 	// Validate user is signer
-	signers := make([]solana.PublicKey, 0)
-	for _, acc := range accounts {
-		if acc.IsSigner {
-			signers = append(signers, acc.PublicKey)
-		}
-	}
-	
-	if err := iv.accountValidator.ValidateSigner(user, signers); err != nil {
+	if err := iv.accountValidator.ValidateSigner(user, signers(accounts)); err != nil {
 		return err
 	}
 	
@@ -109,3 +87,23 @@ func (iv *InstructionValidator) ValidateCreatePosition(
 	return nil
 }
 
+// ValidateSigner validates that the account at index signed the instruction.
+// Handlers call it for the account whose key they act on behalf of.
+func (iv *InstructionValidator) ValidateSigner(accounts []*solanago.AccountMeta, index int) error {
+	if index < 0 || index >= len(accounts) || accounts[index] == nil {
+		return ErrInvalidAccounts
+	}
+
+	return iv.accountValidator.ValidateSigner(accounts[index].PublicKey, signers(accounts))
+}
+
+// signers returns the keys of the accounts that signed the instruction
+func signers(accounts []*solanago.AccountMeta) []solanago.PublicKey {
+	keys := make([]solanago.PublicKey, 0)
+	for _, acc := range accounts {
+		if acc != nil && acc.IsSigner {
+			keys = append(keys, acc.PublicKey)
+		}
+	}
+	return keys
+}
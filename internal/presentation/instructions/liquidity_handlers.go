@@ -0,0 +1,61 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleAddLiquidity handles the add liquidity instruction
+func (h *InstructionHandler) handleAddLiquidity(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][amount(8)]
+	var payload codec.AddLiquidityPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.AddLiquidityInput{
+		MarketID: payload.MarketID,
+		Provider: accounts[0].PublicKey.String(),
+		Amount:   payload.Amount,
+	}
+
+	_, err := h.addLiquidityUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleRemoveLiquidity handles the remove liquidity instruction
+func (h *InstructionHandler) handleRemoveLiquidity(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][lp_shares(8)]
+	var payload codec.RemoveLiquidityPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.RemoveLiquidityInput{
+		MarketID: payload.MarketID,
+		Provider: accounts[0].PublicKey.String(),
+		LPShares: payload.LPShares,
+	}
+
+	_, err := h.removeLiquidityUseCase.Execute(ctx, input)
+	return err
+}
@@ -2,11 +2,11 @@ package instructions
 
 import (
 	"context"
-	"encoding/binary"
 	"time"
 	"github.com/gagliardetto/solana-go"
 	"github.com/polymarket/solana-program/internal/application/usecases"
 	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
 )
 
 // handleCreateMarket handles the create market instruction
@@ -14,94 +14,159 @@ func (h *InstructionHandler) handleCreateMarket(ctx context.Context, data []byte
 	if len(accounts) < 3 {
 		return ErrInvalidAccounts
 	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
 
 	// Parse instruction data
-	// Format: [title_len(4)][title][desc_len(4)][desc][category_len(4)][category][end_date(8)]
-	offset := 0
-
-	// Read title
-	titleLen := binary.LittleEndian.Uint32(data[offset:])
-	offset += 4
-	title := string(data[offset : offset+int(titleLen)])
-	offset += int(titleLen)
-
-	// Read description
-	descLen := binary.LittleEndian.Uint32(data[offset:])
-	offset += 4
-	description := string(data[offset : offset+int(descLen)])
-	offset += int(descLen)
-
-	// Read category
-	categoryLen := binary.LittleEndian.Uint32(data[offset:])
-	offset += 4
-	category := string(data[offset : offset+int(categoryLen)])
-	offset += int(categoryLen)
-
-	// Read end date
-	endDateUnix := binary.LittleEndian.Uint64(data[offset:])
-	endDate := time.Unix(int64(endDateUnix), 0)
+	// Format: [title_len(4)][title][desc_len(4)][desc][category_len(4)][category][end_date(8)][nonce(8)][liquidity(8)][pricing_model(1)][fee_bps(2)][collateral_mint(32)][outcome_tokens(1)][outcome_count(1)][name_len(4)][name]...[scalar(1)][scalar_min(8)][scalar_max(8)][protocol_fee_bps(2)][creator_fee_bps(2)][oracle_kind(1)][oracle_account(32)][oracle_threshold(8)][oracle_exponent(4)][optimistic(1)][proposal_bond(8)][dispute_window(8)][arbiter(32)]
+	var payload codec.CreateMarketPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	pricingModel, err := entities.ParsePricingModel(payload.PricingModel)
+	if err != nil {
+		return err
+	}
 
 	// Get creator from accounts
 	creator := accounts[0].PublicKey.String()
 
+	var collateralMint string
+	if payload.CollateralMint != ([32]byte{}) {
+		collateralMint = solana.PublicKeyFromBytes(payload.CollateralMint[:]).String()
+	}
+
+	var scalar *entities.ScalarRange
+	if payload.Scalar {
+		scalar = &entities.ScalarRange{Min: payload.ScalarMin, Max: payload.ScalarMax}
+	}
+
+	var oracle *entities.Oracle
+	if payload.OracleKind != entities.OracleNoneValue {
+		kind, err := entities.ParseOracleKind(payload.OracleKind)
+		if err != nil {
+			return err
+		}
+		oracle = &entities.Oracle{
+			Kind:      kind,
+			Account:   solana.PublicKeyFromBytes(payload.OracleAccount[:]).String(),
+			Threshold: payload.OracleThreshold,
+			Exponent:  payload.OracleExponent,
+		}
+	}
+
+	var optimistic *entities.OptimisticConfig
+	if payload.Optimistic {
+		optimistic = &entities.OptimisticConfig{
+			Bond:          payload.ProposalBond,
+			DisputeWindow: time.Duration(payload.DisputeWindow) * time.Second,
+			Arbiter:       solana.PublicKeyFromBytes(payload.Arbiter[:]).String(),
+		}
+	}
+
 	// Create market input
 	input := usecases.CreateMarketInput{
-		Title:       title,
-		Description: description,
-		Category:    category,
-		EndDate:     endDate,
-		Creator:     creator,
+		Title:          payload.Title,
+		Description:    payload.Description,
+		Category:       payload.Category,
+		EndDate:        time.Unix(payload.EndDate, 0),
+		Creator:        creator,
+		Nonce:          payload.Nonce,
+		Liquidity:      payload.Liquidity,
+		PricingModel:   pricingModel,
+		FeeBps:         payload.FeeBps,
+		CollateralMint: collateralMint,
+		OutcomeTokens:  payload.OutcomeTokens,
+		Outcomes:       payload.Outcomes,
+		Scalar:         scalar,
+		CreatorFeeBps:  payload.CreatorFeeBps,
+		Oracle:         oracle,
+		Optimistic:     optimistic,
 	}
 
-	_, err := h.createMarketUseCase.Execute(ctx, input)
+	_, err = h.createMarketUseCase.Execute(ctx, input)
 	return err
 }
 
 // handleResolveMarket handles the resolve market instruction
 func (h *InstructionHandler) handleResolveMarket(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
-	if len(data) < 2 {
-		return ErrInvalidInstructionData
+	if len(accounts) < 1 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
 	}
 
 	// Parse instruction data
-	// Format: [market_id_len(4)][market_id][resolution(1)]
-	offset := 0
-
-	marketIDLen := binary.LittleEndian.Uint32(data[offset:])
-	offset += 4
-	marketID := string(data[offset : offset+int(marketIDLen)])
-	offset += int(marketIDLen)
+	// Format: [market_id_len(4)][market_id][resolution(1)][outcome(1)]
+	var payload codec.ResolveMarketPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
 
-	resolution := entities.MarketResolution(data[offset])
+	resolution, err := entities.ParseResolution(payload.Resolution)
+	if err != nil {
+		return err
+	}
 	resolver := accounts[0].PublicKey.String()
 
 	input := usecases.ResolveMarketInput{
-		MarketID:   marketID,
+		MarketID:   payload.MarketID,
 		Resolution: resolution,
+		Outcome:    payload.Outcome,
 		Resolver:   resolver,
 	}
 
 	return h.resolveMarketUseCase.Execute(ctx, input)
 }
 
+// handleResolveScalar handles the resolve scalar instruction
+func (h *InstructionHandler) handleResolveScalar(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 1 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][value(8)]
+	var payload codec.ResolveScalarPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.ResolveScalarInput{
+		MarketID: payload.MarketID,
+		Value:    payload.Value,
+		Resolver: accounts[0].PublicKey.String(),
+	}
+
+	return h.resolveScalarUseCase.Execute(ctx, input)
+}
+
 // handleCloseMarket handles the close market instruction
 func (h *InstructionHandler) handleCloseMarket(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
-	if len(data) < 5 || len(accounts) < 1 {
-		return ErrInvalidInstructionData
+	if len(accounts) < 1 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
 	}
 
 	// Parse instruction data
 	// Format: [market_id_len(4)][market_id]
-	offset := 0
-
-	marketIDLen := binary.LittleEndian.Uint32(data[offset:])
-	offset += 4
-	marketID := string(data[offset : offset+int(marketIDLen)])
+	var payload codec.CloseMarketPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
 
 	closer := accounts[0].PublicKey.String()
 
 	input := usecases.CloseMarketInput{
-		MarketID: marketID,
+		MarketID: payload.MarketID,
 		Closer:   closer,
 	}
 
@@ -109,7 +174,6 @@ func (h *InstructionHandler) handleCloseMarket(ctx context.Context, data []byte,
 }
 
 var (
-	ErrInvalidAccounts      = &InstructionError{Message: "invalid accounts"}
-	ErrInvalidInstructionData = &InstructionError{Message: "invalid instruction data"}
+	ErrInvalidAccounts        = &InstructionError{Message: "invalid accounts"}
+	ErrInvalidInstructionData = codec.ErrInvalidInstructionData
 )
-
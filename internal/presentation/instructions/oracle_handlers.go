@@ -0,0 +1,50 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleResolveFromOracle handles the resolve from oracle instruction.
+// Accounts: [caller, market, oracle account, additional signers...]
+// The oracle account is the price update to resolve from for price feed markets.
+func (h *InstructionHandler) handleResolveFromOracle(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][resolution(1)][outcome(1)][value(8)]
+	var payload codec.ResolveFromOraclePayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	resolution, err := entities.ParseResolution(payload.Resolution)
+	if err != nil {
+		return err
+	}
+
+	var signers []string
+	for _, account := range accounts {
+		if account.IsSigner {
+			signers = append(signers, account.PublicKey.String())
+		}
+	}
+
+	input := usecases.ResolveFromOracleInput{
+		MarketID:    payload.MarketID,
+		Signers:     signers,
+		PriceUpdate: accounts[2].PublicKey.String(),
+		Resolution:  resolution,
+		Outcome:     payload.Outcome,
+		Value:       payload.Value,
+	}
+
+	_, err = h.resolveFromOracleUseCase.Execute(ctx, input)
+	return err
+}
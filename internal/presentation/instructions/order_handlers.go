@@ -0,0 +1,70 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handlePlaceOrder handles the place order instruction
+func (h *InstructionHandler) handlePlaceOrder(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][outcome(1)][side(1)][price(8)][size(8)][nonce(8)]
+	var payload codec.PlaceOrderPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	side, err := entities.ParseOrderSide(payload.Side)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.PlaceOrderInput{
+		MarketID: payload.MarketID,
+		UserID:   accounts[0].PublicKey.String(),
+		Outcome:  payload.Outcome,
+		Side:     side,
+		Price:    payload.Price,
+		Size:     payload.Size,
+		Nonce:    payload.Nonce,
+	}
+
+	_, err = h.placeOrderUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleCancelOrder handles the cancel order instruction
+func (h *InstructionHandler) handleCancelOrder(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [order_id_len(4)][order_id]
+	var payload codec.CancelOrderPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.CancelOrderInput{
+		OrderID: payload.OrderID,
+		UserID:  accounts[0].PublicKey.String(),
+	}
+
+	_, err := h.cancelOrderUseCase.Execute(ctx, input)
+	return err
+}
@@ -0,0 +1,86 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleSplitCollateral handles the split collateral instruction
+func (h *InstructionHandler) handleSplitCollateral(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][amount(8)]
+	var payload codec.SplitCollateralPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.SplitCollateralInput{
+		MarketID: payload.MarketID,
+		UserID:   accounts[0].PublicKey.String(),
+		Amount:   payload.Amount,
+	}
+
+	return h.splitUseCase.Execute(ctx, input)
+}
+
+// handleMergeOutcomeTokens handles the merge outcome tokens instruction
+func (h *InstructionHandler) handleMergeOutcomeTokens(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][amount(8)]
+	var payload codec.MergeOutcomeTokensPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.MergeOutcomeTokensInput{
+		MarketID: payload.MarketID,
+		UserID:   accounts[0].PublicKey.String(),
+		Amount:   payload.Amount,
+	}
+
+	return h.mergeUseCase.Execute(ctx, input)
+}
+
+// handleRedeemOutcomeTokens handles the redeem outcome tokens instruction
+func (h *InstructionHandler) handleRedeemOutcomeTokens(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][outcome(1)][amount(8)]
+	var payload codec.RedeemOutcomeTokensPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.RedeemOutcomeTokensInput{
+		MarketID: payload.MarketID,
+		UserID:   accounts[0].PublicKey.String(),
+		Outcome:  payload.Outcome,
+		Amount:   payload.Amount,
+	}
+
+	_, err := h.redeemUseCase.Execute(ctx, input)
+	return err
+}
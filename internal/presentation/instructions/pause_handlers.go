@@ -0,0 +1,62 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleSetPaused handles the set paused instruction.
+// Accounts: [pauser (signer), config]
+func (h *InstructionHandler) handleSetPaused(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [paused(1)]
+	var payload codec.SetPausedPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.SetPausedInput{
+		Caller: accounts[0].PublicKey.String(),
+		Paused: payload.Paused,
+	}
+
+	_, err := h.setPausedUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleSetMarketFrozen handles the set market frozen instruction.
+// Accounts: [pauser (signer), market, config]
+func (h *InstructionHandler) handleSetMarketFrozen(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][frozen(1)]
+	var payload codec.SetMarketFrozenPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.SetMarketFrozenInput{
+		MarketID: payload.MarketID,
+		Caller:   accounts[0].PublicKey.String(),
+		Frozen:   payload.Frozen,
+	}
+
+	_, err := h.setMarketFrozenUseCase.Execute(ctx, input)
+	return err
+}
@@ -2,46 +2,91 @@ package instructions
 
 import (
 	"context"
-	"encoding/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/polymarket/solana-program/internal/application/usecases"
-	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
 )
 
 // handleCreatePosition handles the create position instruction
 func (h *InstructionHandler) handleCreatePosition(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
-	if len(data) < 25 || len(accounts) < 2 {
-		return ErrInvalidInstructionData
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
 	}
 
 	// Parse instruction data
-	// Format: [market_id_len(4)][market_id][side(1)][amount(8)][price(8)]
-	offset := 0
+	// Format: [market_id_len(4)][market_id][outcome(1)][shares(8)][price(8)][max_slippage_bps(2)]
+	var payload codec.CreatePositionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
 
-	marketIDLen := binary.LittleEndian.Uint32(data[offset:])
-	offset += 4
-	marketID := string(data[offset : offset+int(marketIDLen)])
-	offset += int(marketIDLen)
+	userID := accounts[0].PublicKey.String()
 
-	side := entities.PositionSide(data[offset])
-	offset++
+	input := usecases.CreatePositionInput{
+		MarketID:       payload.MarketID,
+		UserID:         userID,
+		Outcome:        payload.Outcome,
+		Shares:         payload.Shares,
+		Price:          payload.Price,
+		MaxSlippageBps: payload.MaxSlippageBps,
+	}
 
-	amount := binary.LittleEndian.Uint64(data[offset:])
-	offset += 8
+	_, err := h.createPositionUseCase.Execute(ctx, input)
+	return err
+}
 
-	price := binary.LittleEndian.Uint64(data[offset:])
+// handleSellPosition handles the sell position instruction
+func (h *InstructionHandler) handleSellPosition(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 2 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
 
-	userID := accounts[0].PublicKey.String()
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][shares(8)][price(8)][max_slippage_bps(2)]
+	var payload codec.SellPositionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
 
-	input := usecases.CreatePositionInput{
-		MarketID: marketID,
-		UserID:   userID,
-		Side:     side,
-		Amount:   amount,
-		Price:    price,
+	input := usecases.SellPositionInput{
+		MarketID:       payload.MarketID,
+		UserID:         accounts[0].PublicKey.String(),
+		Shares:         payload.Shares,
+		Price:          payload.Price,
+		MaxSlippageBps: payload.MaxSlippageBps,
 	}
 
-	_, err := h.createPositionUseCase.Execute(ctx, input)
+	_, err := h.sellPositionUseCase.Execute(ctx, input)
 	return err
 }
 
+// handleClaimWinnings handles the claim winnings instruction
+func (h *InstructionHandler) handleClaimWinnings(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id]
+	var payload codec.ClaimWinningsPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.ClaimWinningsInput{
+		MarketID: payload.MarketID,
+		UserID:   accounts[0].PublicKey.String(),
+	}
+
+	_, err := h.claimWinningsUseCase.Execute(ctx, input)
+	return err
+}
@@ -0,0 +1,122 @@
+package instructions
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/polymarket/solana-program/internal/application/usecases"
+	"github.com/polymarket/solana-program/internal/domain/entities"
+	"github.com/polymarket/solana-program/internal/presentation/codec"
+)
+
+// handleProposeResolution handles the propose resolution instruction
+func (h *InstructionHandler) handleProposeResolution(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][resolution(1)][outcome(1)][value(8)]
+	var payload codec.ProposeResolutionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	resolution, err := entities.ParseResolution(payload.Resolution)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.ProposeResolutionInput{
+		MarketID:   payload.MarketID,
+		Proposer:   accounts[0].PublicKey.String(),
+		Resolution: resolution,
+		Outcome:    payload.Outcome,
+		Value:      payload.Value,
+	}
+
+	_, err = h.proposeResolutionUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleDisputeResolution handles the dispute resolution instruction
+func (h *InstructionHandler) handleDisputeResolution(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id]
+	var payload codec.DisputeResolutionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.DisputeResolutionInput{
+		MarketID: payload.MarketID,
+		Disputer: accounts[0].PublicKey.String(),
+	}
+
+	_, err := h.disputeResolutionUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleFinalizeResolution handles the finalize resolution instruction
+func (h *InstructionHandler) handleFinalizeResolution(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id]
+	var payload codec.FinalizeResolutionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	input := usecases.FinalizeResolutionInput{
+		MarketID: payload.MarketID,
+	}
+
+	_, err := h.finalizeResolutionUseCase.Execute(ctx, input)
+	return err
+}
+
+// handleArbitrateResolution handles the arbitrate resolution instruction
+func (h *InstructionHandler) handleArbitrateResolution(ctx context.Context, data []byte, accounts []*solana.AccountMeta) error {
+	if len(accounts) < 3 {
+		return ErrInvalidAccounts
+	}
+	if err := h.validator.ValidateSigner(accounts, 0); err != nil {
+		return err
+	}
+
+	// Parse instruction data
+	// Format: [market_id_len(4)][market_id][resolution(1)][outcome(1)][value(8)]
+	var payload codec.ArbitrateResolutionPayload
+	if err := codec.DecodePayload(data, &payload); err != nil {
+		return err
+	}
+
+	resolution, err := entities.ParseResolution(payload.Resolution)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.ArbitrateResolutionInput{
+		MarketID:   payload.MarketID,
+		Arbiter:    accounts[0].PublicKey.String(),
+		Resolution: resolution,
+		Outcome:    payload.Outcome,
+		Value:      payload.Value,
+	}
+
+	_, err = h.arbitrateResolutionUseCase.Execute(ctx, input)
+	return err
+}
@@ -3,6 +3,9 @@ package solana
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"github.com/gagliardetto/solana-go"
 )
 
@@ -46,13 +49,61 @@ func SignatureFromString(s string) (solana.Signature, error) {
 	return solana.Signature(sigBytes), nil
 }
 
-// LamportsToSOL converts lamports to SOL
-func LamportsToSOL(lamports uint64) float64 {
-	return float64(lamports) / 1e9
+// Decimals of common collateral tokens
+const (
+	SOLDecimals  uint8 = 9
+	USDCDecimals uint8 = 6
+)
+
+// MaxDecimals is the largest number of decimals whose scale fits in a uint64
+const MaxDecimals uint8 = 19
+
+// FormatAmount formats an amount of base units as a decimal string,
+// e.g. 1500000 with 6 decimals is "1.5"
+func FormatAmount(amount uint64, decimals uint8) string {
+	if decimals == 0 {
+		return strconv.FormatUint(amount, 10)
+	}
+
+	digits := strconv.FormatUint(amount, 10)
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-int(decimals)]
+	fraction := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	if fraction == "" {
+		return whole
+	}
+	return whole + "." + fraction
 }
 
-// SOLToLamports converts SOL to lamports
-func SOLToLamports(sol float64) uint64 {
-	return uint64(sol * 1e9)
+// ParseAmount parses a decimal string into base units without floating point rounding.
+// It rejects values with more fractional digits than decimals or that overflow a uint64.
+func ParseAmount(s string, decimals uint8) (uint64, error) {
+	if decimals > MaxDecimals {
+		return 0, fmt.Errorf("unsupported decimals: %d", decimals)
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return 0, errors.New("empty amount")
+	}
+	if len(fraction) > int(decimals) {
+		return 0, fmt.Errorf("amount %q has more than %d decimals", s, decimals)
+	}
+
+	digits := whole + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid amount %q", s)
+		}
+	}
+
+	amount, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	return amount, nil
 }
 
@@ -2,7 +2,19 @@ package utils
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// MaxIDLength is the maximum ID length in bytes, so an ID fits in a single PDA seed
+const MaxIDLength = 32
+
+var (
+	ErrEmptyID   = errors.New("id is empty")
+	ErrIDTooLong = fmt.Errorf("id exceeds %d bytes", MaxIDLength)
 )
 
 // GenerateID generates a unique ID
@@ -12,3 +24,28 @@ func GenerateID() string {
 	return hex.EncodeToString(b)
 }
 
+// DeriveID derives a deterministic ID from the given parts.
+// The result is hex-encoded and exactly MaxIDLength bytes long.
+func DeriveID(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		// Length-prefix each part so different splits never collide
+		var length [4]byte
+		binary.LittleEndian.PutUint32(length[:], uint32(len(part)))
+		h.Write(length[:])
+		h.Write(part)
+	}
+	sum := h.Sum(nil)
+	return hex.EncodeToString(sum[:MaxIDLength/2])
+}
+
+// ValidateID checks that an ID is non-empty and fits in a PDA seed
+func ValidateID(id string) error {
+	if id == "" {
+		return ErrEmptyID
+	}
+	if len(id) > MaxIDLength {
+		return ErrIDTooLong
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDeriveID(t *testing.T) {
+	id := DeriveID([]byte("market"), []byte("creator"))
+	if len(id) != MaxIDLength {
+		t.Fatalf("DeriveID() length = %d, want %d", len(id), MaxIDLength)
+	}
+	if again := DeriveID([]byte("market"), []byte("creator")); again != id {
+		t.Fatalf("DeriveID() = %s then %s", id, again)
+	}
+	if split := DeriveID([]byte("marketc"), []byte("reator")); split == id {
+		t.Fatalf("DeriveID() of a different split = %s, want a different ID", split)
+	}
+}
+
+func TestValidateID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr error
+	}{
+		{"derived", DeriveID([]byte("market")), nil},
+		{"empty", "", ErrEmptyID},
+		{"too long", strings.Repeat("a", MaxIDLength+1), ErrIDTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateID(tt.id); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateID() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}